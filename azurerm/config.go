@@ -147,6 +147,7 @@ type ArmClient struct {
 	// Networking
 	applicationGatewayClient        network.ApplicationGatewaysClient
 	applicationSecurityGroupsClient network.ApplicationSecurityGroupsClient
+	bgpServiceCommunitiesClient     network.BgpServiceCommunitiesClient
 	expressRouteAuthsClient         network.ExpressRouteCircuitAuthorizationsClient
 	expressRouteCircuitClient       network.ExpressRouteCircuitsClient
 	expressRoutePeeringsClient      network.ExpressRouteCircuitPeeringsClient
@@ -155,6 +156,8 @@ type ArmClient struct {
 	localNetConnClient              network.LocalNetworkGatewaysClient
 	packetCapturesClient            network.PacketCapturesClient
 	publicIPClient                  network.PublicIPAddressesClient
+	routeFilterRulesClient          network.RouteFilterRulesClient
+	routeFiltersClient              network.RouteFiltersClient
 	routesClient                    network.RoutesClient
 	routeTablesClient               network.RouteTablesClient
 	secGroupClient                  network.SecurityGroupsClient
@@ -709,6 +712,10 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&appSecurityGroupsClient.Client, auth)
 	c.applicationSecurityGroupsClient = appSecurityGroupsClient
 
+	bgpServiceCommunitiesClient := network.NewBgpServiceCommunitiesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&bgpServiceCommunitiesClient.Client, auth)
+	c.bgpServiceCommunitiesClient = bgpServiceCommunitiesClient
+
 	expressRouteAuthsClient := network.NewExpressRouteCircuitAuthorizationsClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&expressRouteAuthsClient.Client, auth)
 	c.expressRouteAuthsClient = expressRouteAuthsClient
@@ -757,6 +764,14 @@ func (c *ArmClient) registerNetworkingClients(endpoint, subscriptionId string, a
 	c.configureClient(&publicIPAddressesClient.Client, auth)
 	c.publicIPClient = publicIPAddressesClient
 
+	routeFilterRulesClient := network.NewRouteFilterRulesClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFilterRulesClient.Client, auth)
+	c.routeFilterRulesClient = routeFilterRulesClient
+
+	routeFiltersClient := network.NewRouteFiltersClientWithBaseURI(endpoint, subscriptionId)
+	c.configureClient(&routeFiltersClient.Client, auth)
+	c.routeFiltersClient = routeFiltersClient
+
 	routesClient := network.NewRoutesClientWithBaseURI(endpoint, subscriptionId)
 	c.configureClient(&routesClient.Client, auth)
 	c.routesClient = routesClient
@@ -0,0 +1,160 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceArmBgpServiceCommunities() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmBgpServiceCommunitiesRead,
+		Schema: map[string]*schema.Schema{
+			"service_community": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"service_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"bgp_community": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"community_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"community_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"service_group": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"service_supported_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"is_authorized_to_use": {
+										Type:     schema.TypeBool,
+										Computed: true,
+									},
+
+									"community_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmBgpServiceCommunitiesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).bgpServiceCommunitiesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	communities := make([]network.BgpServiceCommunity, 0)
+
+	iterator, err := client.ListComplete(ctx)
+	if err != nil {
+		return fmt.Errorf("Error listing BGP Service Communities: %+v", err)
+	}
+	for iterator.NotDone() {
+		communities = append(communities, iterator.Value())
+
+		if err := iterator.Next(); err != nil {
+			return fmt.Errorf("Error listing BGP Service Communities: %+v", err)
+		}
+	}
+
+	d.SetId(time.Now().UTC().String())
+
+	if err := d.Set("service_community", flattenBgpServiceCommunities(communities)); err != nil {
+		return fmt.Errorf("Error flattening `service_community`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenBgpServiceCommunities(input []network.BgpServiceCommunity) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, community := range input {
+		output := make(map[string]interface{})
+
+		if name := community.Name; name != nil {
+			output["name"] = *name
+		}
+
+		bgpCommunities := make([]interface{}, 0)
+		if props := community.BgpServiceCommunityPropertiesFormat; props != nil {
+			if serviceName := props.ServiceName; serviceName != nil {
+				output["service_name"] = *serviceName
+			}
+
+			if values := props.BgpCommunities; values != nil {
+				for _, value := range *values {
+					bgpCommunities = append(bgpCommunities, flattenBgpServiceCommunitiesBgpCommunity(value))
+				}
+			}
+		}
+		output["bgp_community"] = bgpCommunities
+
+		results = append(results, output)
+	}
+
+	return results
+}
+
+func flattenBgpServiceCommunitiesBgpCommunity(input network.BGPCommunity) map[string]interface{} {
+	output := make(map[string]interface{})
+
+	if name := input.CommunityName; name != nil {
+		output["community_name"] = *name
+	}
+	if value := input.CommunityValue; value != nil {
+		output["community_value"] = *value
+	}
+	if group := input.ServiceGroup; group != nil {
+		output["service_group"] = *group
+	}
+	if region := input.ServiceSupportedRegion; region != nil {
+		output["service_supported_region"] = *region
+	}
+	if authorized := input.IsAuthorizedToUse; authorized != nil {
+		output["is_authorized_to_use"] = *authorized
+	}
+
+	prefixes := make([]interface{}, 0)
+	if input.CommunityPrefixes != nil {
+		for _, prefix := range *input.CommunityPrefixes {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	output["community_prefixes"] = prefixes
+
+	return output
+}
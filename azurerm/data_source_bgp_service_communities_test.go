@@ -0,0 +1,30 @@
+package azurerm
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMBgpServiceCommunities_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_bgp_service_communities.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMBgpServiceCommunities_basic,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_community.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_community.0.name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_community.0.bgp_community.0.community_value"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceAzureRMBgpServiceCommunities_basic = `
+data "azurerm_bgp_service_communities" "test" {}
+`
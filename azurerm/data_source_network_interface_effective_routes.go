@@ -0,0 +1,141 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmNetworkInterfaceEffectiveRoutes() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkInterfaceEffectiveRoutesRead,
+		Schema: map[string]*schema.Schema{
+			"network_interface_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"route": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"source": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"address_prefixes": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+
+						"next_hop_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"next_hop_ip_addresses": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkInterfaceEffectiveRoutesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	name := d.Get("network_interface_name").(string)
+
+	iface, err := client.Get(ctx, resGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(iface.Response) {
+			return fmt.Errorf("Error: Network Interface %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	// the Effective Routes are only available once the Network Interface is attached to a running Virtual Machine
+	future, err := client.GetEffectiveRouteTable(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Routes for Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.SetId(*iface.ID)
+
+	if err := d.Set("route", flattenArmNetworkInterfaceEffectiveRoutes(result.Value)); err != nil {
+		return fmt.Errorf("Error flattening `route`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmNetworkInterfaceEffectiveRoutes(input *[]network.EffectiveRoute) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, route := range *input {
+		result := make(map[string]interface{}, 0)
+
+		if name := route.Name; name != nil {
+			result["name"] = *name
+		}
+		result["source"] = string(route.Source)
+		result["state"] = string(route.State)
+		result["next_hop_type"] = string(route.NextHopType)
+
+		addressPrefixes := make([]interface{}, 0)
+		if prefixes := route.AddressPrefix; prefixes != nil {
+			for _, prefix := range *prefixes {
+				addressPrefixes = append(addressPrefixes, prefix)
+			}
+		}
+		result["address_prefixes"] = addressPrefixes
+
+		nextHopIPAddresses := make([]interface{}, 0)
+		if addresses := route.NextHopIPAddress; addresses != nil {
+			for _, address := range *addresses {
+				nextHopIPAddresses = append(nextHopIPAddresses, address)
+			}
+		}
+		result["next_hop_ip_addresses"] = nextHopIPAddresses
+
+		results = append(results, result)
+	}
+
+	return results
+}
@@ -0,0 +1,231 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmNetworkInterfaceEffectiveSecurityRules() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkInterfaceEffectiveSecurityRulesRead,
+		Schema: map[string]*schema.Schema{
+			"network_interface_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"network_security_group": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"subnet_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"network_interface_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"security_rule": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"priority": {
+										Type:     schema.TypeInt,
+										Computed: true,
+									},
+
+									"direction": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"access": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"protocol": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"source_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"destination_port_ranges": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"expanded_source_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+
+									"expanded_destination_address_prefixes": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkInterfaceEffectiveSecurityRulesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	name := d.Get("network_interface_name").(string)
+
+	iface, err := client.Get(ctx, resGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(iface.Response) {
+			return fmt.Errorf("Error: Network Interface %q (Resource Group %q) was not found", name, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Azure Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	// the Effective Security Rules are only available once the Network Interface is attached to a running Virtual Machine
+	future, err := client.ListEffectiveNetworkSecurityGroups(ctx, resGroup, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Network Security Groups for Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the Effective Network Security Groups for Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Effective Network Security Groups for Network Interface %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.SetId(*iface.ID)
+
+	if err := d.Set("network_security_group", flattenArmNetworkInterfaceEffectiveNetworkSecurityGroups(result.Value)); err != nil {
+		return fmt.Errorf("Error flattening `network_security_group`: %+v", err)
+	}
+
+	return nil
+}
+
+func flattenArmNetworkInterfaceEffectiveNetworkSecurityGroups(input *[]network.EffectiveNetworkSecurityGroup) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, group := range *input {
+		result := make(map[string]interface{}, 0)
+
+		if nsg := group.NetworkSecurityGroup; nsg != nil && nsg.ID != nil {
+			result["id"] = *nsg.ID
+		}
+
+		if association := group.Association; association != nil {
+			if subnet := association.Subnet; subnet != nil && subnet.ID != nil {
+				result["subnet_id"] = *subnet.ID
+			}
+			if iface := association.NetworkInterface; iface != nil && iface.ID != nil {
+				result["network_interface_id"] = *iface.ID
+			}
+		}
+
+		rules := make([]interface{}, 0)
+		if input := group.EffectiveSecurityRules; input != nil {
+			for _, rule := range *input {
+				rules = append(rules, flattenArmNetworkInterfaceEffectiveSecurityRule(rule))
+			}
+		}
+		result["security_rule"] = rules
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+func flattenArmNetworkInterfaceEffectiveSecurityRule(rule network.EffectiveNetworkSecurityRule) map[string]interface{} {
+	result := make(map[string]interface{}, 0)
+
+	if name := rule.Name; name != nil {
+		result["name"] = *name
+	}
+	if priority := rule.Priority; priority != nil {
+		result["priority"] = int(*priority)
+	}
+	result["direction"] = string(rule.Direction)
+	result["access"] = string(rule.Access)
+	result["protocol"] = string(rule.Protocol)
+
+	// the API returns either the singular or the plural form of these fields, so we combine them
+	result["source_port_ranges"] = flattenArmNetworkInterfaceEffectiveSecurityRuleValues(rule.SourcePortRange, rule.SourcePortRanges)
+	result["destination_port_ranges"] = flattenArmNetworkInterfaceEffectiveSecurityRuleValues(rule.DestinationPortRange, rule.DestinationPortRanges)
+	result["source_address_prefixes"] = flattenArmNetworkInterfaceEffectiveSecurityRuleValues(rule.SourceAddressPrefix, rule.SourceAddressPrefixes)
+	result["destination_address_prefixes"] = flattenArmNetworkInterfaceEffectiveSecurityRuleValues(rule.DestinationAddressPrefix, rule.DestinationAddressPrefixes)
+	result["expanded_source_address_prefixes"] = flattenArmNetworkInterfaceEffectiveSecurityRuleValues(nil, rule.ExpandedSourceAddressPrefix)
+	result["expanded_destination_address_prefixes"] = flattenArmNetworkInterfaceEffectiveSecurityRuleValues(nil, rule.ExpandedDestinationAddressPrefix)
+
+	return result
+}
+
+func flattenArmNetworkInterfaceEffectiveSecurityRuleValues(single *string, multiple *[]string) []interface{} {
+	results := make([]interface{}, 0)
+
+	if single != nil && *single != "" {
+		results = append(results, *single)
+	}
+
+	if multiple != nil {
+		for _, v := range *multiple {
+			results = append(results, v)
+		}
+	}
+
+	return results
+}
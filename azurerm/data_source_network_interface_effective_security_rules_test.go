@@ -0,0 +1,45 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceAzureRMNetworkInterfaceEffectiveSecurityRules_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_interface_effective_security_rules.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkInterfaceEffectiveSecurityRules_basic(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttr(dataSourceName, "network_security_group.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_security_group.0.id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_security_group.0.network_interface_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "network_security_group.0.security_rule.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMNetworkInterfaceEffectiveSecurityRules_basic(rInt int, location string) string {
+	config := testAccDataSourceAzureRMNetworkInterfaceEffective_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_interface_effective_security_rules" "test" {
+  network_interface_name = "${azurerm_network_interface.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+
+  depends_on = ["azurerm_virtual_machine.test"]
+}
+`, config)
+}
@@ -0,0 +1,129 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmNetworkWatcherIPFlowVerify() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkWatcherIPFlowVerifyRead,
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"target_resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Inbound),
+					string(network.Outbound),
+				}, false),
+			},
+
+			"protocol": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.ProtocolTCP),
+					string(network.ProtocolUDP),
+				}, false),
+			},
+
+			"local_ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"local_port": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"remote_ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"remote_port": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_network_interface_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"access": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"rule_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkWatcherIPFlowVerifyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	watcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	targetResourceId := d.Get("target_resource_id").(string)
+
+	parameters := network.VerificationIPFlowParameters{
+		TargetResourceID: utils.String(targetResourceId),
+		Direction:        network.Direction(d.Get("direction").(string)),
+		Protocol:         network.Protocol(d.Get("protocol").(string)),
+		LocalIPAddress:   utils.String(d.Get("local_ip_address").(string)),
+		LocalPort:        utils.String(d.Get("local_port").(string)),
+		RemoteIPAddress:  utils.String(d.Get("remote_ip_address").(string)),
+		RemotePort:       utils.String(d.Get("remote_port").(string)),
+	}
+
+	if v, ok := d.GetOk("target_network_interface_id"); ok {
+		parameters.TargetNicResourceID = utils.String(v.(string))
+	}
+
+	future, err := client.VerifyIPFlow(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error verifying IP Flow for %q (Watcher %q / Resource Group %q): %+v", targetResourceId, watcherName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the IP Flow Verification for %q (Watcher %q / Resource Group %q): %+v", targetResourceId, watcherName, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the IP Flow Verification for %q (Watcher %q / Resource Group %q): %+v", targetResourceId, watcherName, resourceGroup, err)
+	}
+
+	// the IP Flow Verification is computed on demand and doesn't have an ID of its own
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("access", string(result.Access))
+	d.Set("rule_name", result.RuleName)
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAzureRMNetworkWatcherIPFlowVerify_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_watcher_ip_flow_verify.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkWatcherIPFlowVerify_basicConfig(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "access", "Allow"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "rule_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMNetworkWatcherIPFlowVerify_basicConfig(rInt int, location string) string {
+	config := testAzureRMPacketCapture_base(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_ip_flow_verify" "test" {
+  network_watcher_name = "${azurerm_network_watcher.test.name}"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  target_resource_id   = "${azurerm_virtual_machine.test.id}"
+  direction            = "Outbound"
+  protocol             = "TCP"
+  local_ip_address     = "${azurerm_network_interface.test.private_ip_address}"
+  local_port           = "60000"
+  remote_ip_address    = "8.8.8.8"
+  remote_port          = "443"
+
+  depends_on = ["azurerm_virtual_machine_extension.test"]
+}
+`, config)
+}
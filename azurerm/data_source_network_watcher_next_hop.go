@@ -0,0 +1,102 @@
+package azurerm
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmNetworkWatcherNextHop() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmNetworkWatcherNextHopRead,
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"target_resource_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"source_ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"destination_ip_address": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"target_network_interface_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"next_hop_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"next_hop_ip_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmNetworkWatcherNextHopRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	watcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	targetResourceId := d.Get("target_resource_id").(string)
+
+	parameters := network.NextHopParameters{
+		TargetResourceID:     utils.String(targetResourceId),
+		SourceIPAddress:      utils.String(d.Get("source_ip_address").(string)),
+		DestinationIPAddress: utils.String(d.Get("destination_ip_address").(string)),
+	}
+
+	if v, ok := d.GetOk("target_network_interface_id"); ok {
+		parameters.TargetNicResourceID = utils.String(v.(string))
+	}
+
+	future, err := client.GetNextHop(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Next Hop for %q (Watcher %q / Resource Group %q): %+v", targetResourceId, watcherName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the Next Hop for %q (Watcher %q / Resource Group %q): %+v", targetResourceId, watcherName, resourceGroup, err)
+	}
+
+	result, err := future.Result(client)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Next Hop for %q (Watcher %q / Resource Group %q): %+v", targetResourceId, watcherName, resourceGroup, err)
+	}
+
+	// the Next Hop is computed on demand and doesn't have an ID of its own
+	d.SetId(time.Now().UTC().String())
+
+	d.Set("next_hop_type", string(result.NextHopType))
+	d.Set("next_hop_ip_address", result.NextHopIPAddress)
+	d.Set("route_table_id", result.RouteTableID)
+
+	return nil
+}
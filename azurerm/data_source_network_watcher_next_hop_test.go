@@ -0,0 +1,45 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func testAccDataSourceAzureRMNetworkWatcherNextHop_basic(t *testing.T) {
+	dataSourceName := "data.azurerm_network_watcher_next_hop.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceAzureRMNetworkWatcherNextHop_basicConfig(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "next_hop_type", "Internet"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "route_table_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceAzureRMNetworkWatcherNextHop_basicConfig(rInt int, location string) string {
+	config := testAzureRMPacketCapture_base(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_watcher_next_hop" "test" {
+  network_watcher_name   = "${azurerm_network_watcher.test.name}"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  target_resource_id     = "${azurerm_virtual_machine.test.id}"
+  source_ip_address      = "${azurerm_network_interface.test.private_ip_address}"
+  destination_ip_address = "8.8.8.8"
+
+  depends_on = ["azurerm_virtual_machine_extension.test"]
+}
+`, config)
+}
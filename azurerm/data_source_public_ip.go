@@ -38,6 +38,11 @@ func dataSourceArmPublicIP() *schema.Resource {
 				Computed: true,
 			},
 
+			"ip_version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -75,6 +80,8 @@ func dataSourceArmPublicIPRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("ip_address", resp.PublicIPAddressPropertiesFormat.IPAddress)
 	}
 
+	d.Set("ip_version", string(resp.PublicIPAddressPropertiesFormat.PublicIPAddressVersion))
+
 	if resp.PublicIPAddressPropertiesFormat.IdleTimeoutInMinutes != nil {
 		d.Set("idle_timeout_in_minutes", *resp.PublicIPAddressPropertiesFormat.IdleTimeoutInMinutes)
 	}
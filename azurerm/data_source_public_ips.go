@@ -63,6 +63,10 @@ func dataSourceArmPublicIPs() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+						"ip_version": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
 					},
 				},
 			},
@@ -168,6 +172,8 @@ func flattenDataSourcePublicIP(input network.PublicIPAddress) map[string]string
 		if ip := props.IPAddress; ip != nil {
 			output["ip_address"] = *ip
 		}
+
+		output["ip_version"] = string(props.PublicIPAddressVersion)
 	}
 
 	return output
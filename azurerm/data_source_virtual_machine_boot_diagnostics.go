@@ -0,0 +1,201 @@
+package azurerm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/storage"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualMachineBootDiagnostics() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualMachineBootDiagnosticsRead,
+		Schema: map[string]*schema.Schema{
+			"virtual_machine_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"sas_expiry_in_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(1, 1440),
+			},
+
+			"screenshot_blob_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"screenshot_sas_uri": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"serial_console_log_blob_uri": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"serial_console_log": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualMachineBootDiagnosticsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vmClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resGroup := d.Get("resource_group_name").(string)
+	vmName := d.Get("virtual_machine_name").(string)
+
+	vm, err := client.Get(ctx, resGroup, vmName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(vm.Response) {
+			return fmt.Errorf("Error: Virtual Machine %q (Resource Group %q) was not found", vmName, resGroup)
+		}
+		return fmt.Errorf("Error making Read request on Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	instanceView, err := client.InstanceView(ctx, resGroup, vmName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Instance View for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	d.SetId(*vm.ID)
+
+	screenshotUri := ""
+	serialConsoleLogUri := ""
+	if diagnostics := instanceView.BootDiagnostics; diagnostics != nil {
+		if diagnostics.ConsoleScreenshotBlobURI != nil {
+			screenshotUri = *diagnostics.ConsoleScreenshotBlobURI
+		}
+		if diagnostics.SerialConsoleLogBlobURI != nil {
+			serialConsoleLogUri = *diagnostics.SerialConsoleLogBlobURI
+		}
+	}
+
+	d.Set("screenshot_blob_uri", screenshotUri)
+	d.Set("serial_console_log_blob_uri", serialConsoleLogUri)
+
+	// the Blob URIs point to a private Storage Container, so the Screenshot is exposed using a read-only SAS URI
+	screenshotSasUri := ""
+	if screenshotUri != "" {
+		blob, err := getVirtualMachineBootDiagnosticsBlob(screenshotUri, meta)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Screenshot for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+		}
+
+		if blob != nil {
+			expiry := time.Duration(d.Get("sas_expiry_in_minutes").(int)) * time.Minute
+			options := storage.BlobSASOptions{
+				BlobServiceSASPermissions: storage.BlobServiceSASPermissions{
+					Read: true,
+				},
+				SASOptions: storage.SASOptions{
+					Expiry:   time.Now().UTC().Add(expiry),
+					UseHTTPS: true,
+				},
+			}
+			screenshotSasUri, err = blob.GetSASURI(options)
+			if err != nil {
+				return fmt.Errorf("Error generating a SAS URI for the Screenshot of Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+			}
+		}
+	}
+	d.Set("screenshot_sas_uri", screenshotSasUri)
+
+	serialConsoleLog := ""
+	if serialConsoleLogUri != "" {
+		serialConsoleLog, err = retrieveVirtualMachineBootDiagnosticsBlob(serialConsoleLogUri, meta)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the Serial Console Log for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+		}
+	}
+	d.Set("serial_console_log", serialConsoleLog)
+
+	return nil
+}
+
+func retrieveVirtualMachineBootDiagnosticsBlob(uri string, meta interface{}) (string, error) {
+	blob, err := getVirtualMachineBootDiagnosticsBlob(uri, meta)
+	if err != nil || blob == nil {
+		return "", err
+	}
+
+	reader, err := blob.Get(nil)
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving Boot Diagnostics blob %q: %+v", blob.Name, err)
+	}
+	defer reader.Close()
+
+	contents, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return "", fmt.Errorf("Error reading Boot Diagnostics blob %q: %+v", blob.Name, err)
+	}
+
+	return string(contents), nil
+}
+
+// getVirtualMachineBootDiagnosticsBlob returns a reference to the Boot Diagnostics Blob at the given URI,
+// or nil if either the Storage Account or the Blob doesn't exist (yet)
+func getVirtualMachineBootDiagnosticsBlob(uri string, meta interface{}) (*storage.Blob, error) {
+	blobURL, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot parse Boot Diagnostics Blob URI: %s", err)
+	}
+
+	// Blob URI is in the form: https://storageAccountName.blob.core.windows.net/containerName/blobName
+	storageAccountName := strings.Split(blobURL.Host, ".")[0]
+	path := strings.SplitN(strings.TrimPrefix(blobURL.Path, "/"), "/", 2)
+	if len(path) != 2 {
+		return nil, fmt.Errorf("Expected a Container and Blob Name in the Boot Diagnostics Blob URI %q", uri)
+	}
+	containerName := path[0]
+	blobName := path[1]
+
+	storageAccountResourceGroupName, err := findStorageAccountResourceGroup(meta, storageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("Error finding resource group for storage account %s: %+v", storageAccountName, err)
+	}
+
+	armClient := meta.(*ArmClient)
+	ctx := armClient.StopContext
+
+	blobClient, saExists, err := armClient.getBlobStorageClientForStorageAccount(ctx, storageAccountResourceGroupName, storageAccountName)
+	if err != nil {
+		return nil, fmt.Errorf("Error creating blob store client for Boot Diagnostics: %+v", err)
+	}
+
+	if !saExists {
+		log.Printf("[INFO] Storage Account %q in resource group %q doesn't exist so the Boot Diagnostics blob won't exist", storageAccountName, storageAccountResourceGroupName)
+		return nil, nil
+	}
+
+	container := blobClient.GetContainerReference(containerName)
+	blob := container.GetBlobReference(blobName)
+	exists, err := blob.Exists()
+	if err != nil {
+		return nil, fmt.Errorf("Error checking if Boot Diagnostics blob %q exists: %+v", blobName, err)
+	}
+	if !exists {
+		log.Printf("[INFO] Boot Diagnostics blob %q doesn't exist yet", blobName)
+		return nil, nil
+	}
+
+	return blob, nil
+}
@@ -50,6 +50,97 @@ func dataSourceArmVirtualNetwork() *schema.Resource {
 				Type:     schema.TypeMap,
 				Computed: true,
 			},
+
+			"subnet": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"address_prefix": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"network_security_group_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"route_table_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"vnet_peering": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"remote_virtual_network_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"allow_virtual_network_access": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"allow_forwarded_traffic": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"allow_gateway_transit": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"use_remote_gateways": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+
+						"peering_state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"enable_ddos_protection": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"enable_vm_protection": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -93,6 +184,17 @@ func dataSourceArmVnetRead(d *schema.ResourceData, meta interface{}) error {
 		if err := d.Set("vnet_peerings", vnetPeerings); err != nil {
 			return err
 		}
+
+		if err := d.Set("subnet", flattenVnetSubnetDetails(props.Subnets)); err != nil {
+			return fmt.Errorf("Error flattening `subnet`: %+v", err)
+		}
+
+		if err := d.Set("vnet_peering", flattenVnetPeeringDetails(props.VirtualNetworkPeerings)); err != nil {
+			return fmt.Errorf("Error flattening `vnet_peering`: %+v", err)
+		}
+
+		d.Set("enable_ddos_protection", props.EnableDdosProtection)
+		d.Set("enable_vm_protection", props.EnableVMProtection)
 	}
 	return nil
 }
@@ -133,3 +235,78 @@ func flattenVnetPeerings(input *[]network.VirtualNetworkPeering) map[string]inte
 	}
 	return output
 }
+
+func flattenVnetSubnetDetails(input *[]network.Subnet) []interface{} {
+	subnets := make([]interface{}, 0)
+	if input == nil {
+		return subnets
+	}
+
+	for _, subnet := range *input {
+		output := make(map[string]interface{})
+
+		if id := subnet.ID; id != nil {
+			output["id"] = *id
+		}
+		if name := subnet.Name; name != nil {
+			output["name"] = *name
+		}
+
+		if props := subnet.SubnetPropertiesFormat; props != nil {
+			if prefix := props.AddressPrefix; prefix != nil {
+				output["address_prefix"] = *prefix
+			}
+			if nsg := props.NetworkSecurityGroup; nsg != nil && nsg.ID != nil {
+				output["network_security_group_id"] = *nsg.ID
+			}
+			if table := props.RouteTable; table != nil && table.ID != nil {
+				output["route_table_id"] = *table.ID
+			}
+		}
+
+		subnets = append(subnets, output)
+	}
+
+	return subnets
+}
+
+func flattenVnetPeeringDetails(input *[]network.VirtualNetworkPeering) []interface{} {
+	peerings := make([]interface{}, 0)
+	if input == nil {
+		return peerings
+	}
+
+	for _, peering := range *input {
+		output := make(map[string]interface{})
+
+		if id := peering.ID; id != nil {
+			output["id"] = *id
+		}
+		if name := peering.Name; name != nil {
+			output["name"] = *name
+		}
+
+		if props := peering.VirtualNetworkPeeringPropertiesFormat; props != nil {
+			if remote := props.RemoteVirtualNetwork; remote != nil && remote.ID != nil {
+				output["remote_virtual_network_id"] = *remote.ID
+			}
+			if v := props.AllowVirtualNetworkAccess; v != nil {
+				output["allow_virtual_network_access"] = *v
+			}
+			if v := props.AllowForwardedTraffic; v != nil {
+				output["allow_forwarded_traffic"] = *v
+			}
+			if v := props.AllowGatewayTransit; v != nil {
+				output["allow_gateway_transit"] = *v
+			}
+			if v := props.UseRemoteGateways; v != nil {
+				output["use_remote_gateways"] = *v
+			}
+			output["peering_state"] = string(props.PeeringState)
+		}
+
+		peerings = append(peerings, output)
+	}
+
+	return peerings
+}
@@ -117,6 +117,21 @@ func dataSourceArmVirtualNetworkGateway() *schema.Resource {
 							},
 							Set: hashVirtualNetworkGatewayRevokedCert,
 						},
+						"radius_server_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"radius_server_secret": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+						"vpn_client_protocols": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+							Set:      hashVirtualNetworkGatewayVpnClientProtocol,
+						},
 					},
 				},
 			},
@@ -0,0 +1,163 @@
+package azurerm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualNetworkGatewayConnection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualNetworkGatewayConnectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"location": locationForDataSourceSchema(),
+
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"virtual_network_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"authorization_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"express_route_circuit_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"peer_virtual_network_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"local_network_gateway_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"enable_bgp": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"use_policy_based_traffic_selectors": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"routing_weight": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"shared_key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ingress_bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"egress_bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"resource_guid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"tags": tagsForDataSourceSchema(),
+		},
+	}
+}
+
+func dataSourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetGatewayConnectionsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	resp, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Virtual Network Gateway Connection %q (Resource Group %q) was not found!", name, resGroup)
+		}
+
+		return fmt.Errorf("Error making Read request on AzureRM Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	d.SetId(*resp.ID)
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if conn := resp.VirtualNetworkGatewayConnectionPropertiesFormat; conn != nil {
+		d.Set("type", string(conn.ConnectionType))
+		d.Set("authorization_key", conn.AuthorizationKey)
+		d.Set("enable_bgp", conn.EnableBgp)
+		d.Set("use_policy_based_traffic_selectors", conn.UsePolicyBasedTrafficSelectors)
+		d.Set("routing_weight", conn.RoutingWeight)
+		d.Set("shared_key", conn.SharedKey)
+		d.Set("connection_status", string(conn.ConnectionStatus))
+		d.Set("resource_guid", conn.ResourceGUID)
+
+		if conn.VirtualNetworkGateway1 != nil {
+			d.Set("virtual_network_gateway_id", conn.VirtualNetworkGateway1.ID)
+		}
+
+		if conn.Peer != nil {
+			d.Set("express_route_circuit_id", conn.Peer.ID)
+		}
+
+		if conn.VirtualNetworkGateway2 != nil {
+			d.Set("peer_virtual_network_gateway_id", conn.VirtualNetworkGateway2.ID)
+		}
+
+		if conn.LocalNetworkGateway2 != nil {
+			d.Set("local_network_gateway_id", conn.LocalNetworkGateway2.ID)
+		}
+
+		if conn.IngressBytesTransferred != nil {
+			d.Set("ingress_bytes_transferred", int(*conn.IngressBytesTransferred))
+		}
+
+		if conn.EgressBytesTransferred != nil {
+			d.Set("egress_bytes_transferred", int(*conn.EgressBytesTransferred))
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
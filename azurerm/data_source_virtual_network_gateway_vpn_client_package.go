@@ -0,0 +1,172 @@
+package azurerm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmVirtualNetworkGatewayVpnClientPackage() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmVirtualNetworkGatewayVpnClientPackageRead,
+
+		Schema: map[string]*schema.Schema{
+			"virtual_network_gateway_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"resource_group_name": resourceGroupNameForDataSourceSchema(),
+
+			"processor_architecture": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.Amd64),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Amd64),
+					string(network.X86),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"authentication_method": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.EAPMSCHAPv2),
+					string(network.EAPTLS),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
+			"radius_server_auth_certificate": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"client_root_certificates": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceArmVirtualNetworkGatewayVpnClientPackageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).vnetGatewayClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("virtual_network_gateway_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+
+	gateway, err := client.Get(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(gateway.Response) {
+			return fmt.Errorf("Error: Virtual Network Gateway %q (Resource Group %q) was not found", name, resourceGroup)
+		}
+		return fmt.Errorf("Error making Read request on Virtual Network Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if gateway.ID == nil {
+		return fmt.Errorf("Cannot read Virtual Network Gateway %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	// a VPN Profile is generated on each read so that it always reflects both the specified arguments and the
+	// current `vpn_client_configuration` of the Virtual Network Gateway, and has a SAS link which hasn't expired
+	url, err := generateArmVirtualNetworkGatewayVpnProfile(ctx, client, d, resourceGroup, name)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(*gateway.ID)
+	d.Set("url", url)
+
+	return nil
+}
+
+func generateArmVirtualNetworkGatewayVpnProfile(ctx context.Context, client network.VirtualNetworkGatewaysClient, d *schema.ResourceData, resourceGroup string, name string) (*string, error) {
+	parameters := network.VpnClientParameters{
+		ProcessorArchitecture: network.ProcessorArchitecture(d.Get("processor_architecture").(string)),
+		AuthenticationMethod:  network.AuthenticationMethod(d.Get("authentication_method").(string)),
+	}
+
+	if v := d.Get("radius_server_auth_certificate").(string); v != "" {
+		parameters.RadiusServerAuthCertificate = utils.String(v)
+	}
+
+	if v := d.Get("client_root_certificates").([]interface{}); len(v) > 0 {
+		certificates := make([]string, 0, len(v))
+		for _, certificate := range v {
+			certificates = append(certificates, certificate.(string))
+		}
+		parameters.ClientRootCertificates = &certificates
+	}
+
+	log.Printf("[DEBUG] Generating a VPN Profile for Virtual Network Gateway %q (Resource Group %q)..", name, resourceGroup)
+	future, err := client.GenerateVpnProfile(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return nil, fmt.Errorf("Error generating VPN Profile for Virtual Network Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return nil, fmt.Errorf("Error waiting for generation of the VPN Profile for Virtual Network Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	profile, err := future.Result(client)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving VPN Profile for Virtual Network Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	if profile.Value != nil && *profile.Value != "" {
+		return profile.Value, nil
+	}
+
+	// fall back to retrieving the URL of the VPN Profile which was just generated
+	return getArmVirtualNetworkGatewayVpnProfilePackageUrl(ctx, client, resourceGroup, name)
+}
+
+// getArmVirtualNetworkGatewayVpnProfilePackageUrl returns the URL of the most recently generated VPN Profile,
+// or nil if a VPN Profile hasn't been generated for the Virtual Network Gateway - which is used when
+// GenerateVpnProfile doesn't return the URL itself
+func getArmVirtualNetworkGatewayVpnProfilePackageUrl(ctx context.Context, client network.VirtualNetworkGatewaysClient, resourceGroup string, name string) (*string, error) {
+	future, err := client.GetVpnProfilePackageURL(ctx, resourceGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: future.Response()}) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving VPN Profile Package URL for Virtual Network Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		if utils.ResponseWasNotFound(autorest.Response{Response: future.Response()}) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error waiting for the VPN Profile Package URL for Virtual Network Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	packageUrl, err := future.Result(client)
+	if err != nil {
+		if utils.ResponseWasNotFound(packageUrl.Response) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Error retrieving VPN Profile Package URL for Virtual Network Gateway %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return packageUrl.Value, nil
+}
@@ -26,6 +26,10 @@ func TestAccDataSourceArmVirtualNetwork_basic(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "dns_servers.0", "10.0.0.4"),
 					resource.TestCheckResourceAttr(dataSourceName, "address_spaces.0", "10.0.0.0/16"),
 					resource.TestCheckResourceAttr(dataSourceName, "subnets.0", "subnet1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnet.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnet.0.name", "subnet1"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnet.0.address_prefix", "10.0.1.0/24"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "subnet.0.id"),
 				),
 			},
 		},
@@ -52,6 +56,9 @@ func TestAccDataSourceArmVirtualNetwork_peering(t *testing.T) {
 					resource.TestCheckResourceAttr(dataSourceName, "name", virtualNetworkName),
 					resource.TestCheckResourceAttr(dataSourceName, "address_spaces.0", "10.0.1.0/24"),
 					resource.TestCheckResourceAttr(dataSourceName, "vnet_peerings.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vnet_peering.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "vnet_peering.0.name", "peer-1to2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "vnet_peering.0.remote_virtual_network_id"),
 				),
 			},
 		},
@@ -0,0 +1,583 @@
+package zonefile
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultTTL is used for records when neither a $TTL directive nor an explicit TTL precedes them
+const DefaultTTL = 3600
+
+// RecordSet is the set of records in a zone sharing an owner name and type. The Name is relative to the
+// zone apex (`@` for the apex itself) and each entry in Records is the normalised RDATA for a single record.
+type RecordSet struct {
+	Name    string
+	Type    string
+	TTL     int64
+	Records []string
+}
+
+var supportedTypes = map[string]bool{
+	"A":     true,
+	"AAAA":  true,
+	"CNAME": true,
+	"MX":    true,
+	"NS":    true,
+	"PTR":   true,
+	"SRV":   true,
+	"TXT":   true,
+}
+
+type token struct {
+	value  string
+	quoted bool
+}
+
+type line struct {
+	number        int
+	tokens        []token
+	inheritsOwner bool
+}
+
+// Parse parses an RFC 1035 zone file for the zone `origin` into record sets, sorted by name and type.
+// The SOA record and the NS records at the zone apex are skipped, since these are managed by Azure.
+func Parse(input string, origin string) ([]RecordSet, error) {
+	lines, err := tokenize(input)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := absoluteName(origin, ".")
+	currentOrigin := zone
+	owner := ""
+	defaultTTL := int64(-1)
+	lastTTL := int64(-1)
+
+	sets := make(map[string]*RecordSet)
+	typesByName := make(map[string][]string)
+
+	for _, l := range lines {
+		tokens := l.tokens
+
+		if first := tokens[0]; !first.quoted && strings.HasPrefix(first.value, "$") {
+			directive := strings.ToUpper(first.value)
+			switch directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: expected a single domain name for $ORIGIN", l.number)
+				}
+				currentOrigin = absoluteName(tokens[1].value, currentOrigin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: expected a single value for $TTL", l.number)
+				}
+				ttl, err := parseTTL(tokens[1].value)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %+v", l.number, err)
+				}
+				defaultTTL = ttl
+			default:
+				return nil, fmt.Errorf("line %d: the %s directive is not supported", l.number, directive)
+			}
+			continue
+		}
+
+		if !l.inheritsOwner {
+			owner = absoluteName(tokens[0].value, currentOrigin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: no owner name was specified and there is no previous owner", l.number)
+		}
+
+		ttl := int64(-1)
+		for len(tokens) > 0 && !tokens[0].quoted {
+			value := strings.ToUpper(tokens[0].value)
+			if value == "IN" {
+				tokens = tokens[1:]
+				continue
+			}
+			if value == "CH" || value == "HS" || value == "CS" {
+				return nil, fmt.Errorf("line %d: the %s class is not supported", l.number, value)
+			}
+			if ttl == -1 {
+				if v, err := parseTTL(value); err == nil {
+					ttl = v
+					lastTTL = v
+					tokens = tokens[1:]
+					continue
+				}
+			}
+			break
+		}
+
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: expected a record type", l.number)
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		rdata := tokens[1:]
+
+		if recordType == "SOA" {
+			continue
+		}
+		if !supportedTypes[recordType] {
+			return nil, fmt.Errorf("line %d: records of type %q are not supported", l.number, recordType)
+		}
+
+		name, err := relativeName(owner, zone)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", l.number, err)
+		}
+
+		if recordType == "NS" && name == "@" {
+			continue
+		}
+
+		record, err := parseRecordData(recordType, rdata, currentOrigin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %+v", l.number, err)
+		}
+
+		if ttl == -1 {
+			switch {
+			case defaultTTL != -1:
+				ttl = defaultTTL
+			case lastTTL != -1:
+				ttl = lastTTL
+			default:
+				ttl = DefaultTTL
+			}
+		}
+
+		key := fmt.Sprintf("%s/%s", name, recordType)
+		set, exists := sets[key]
+		if !exists {
+			set = &RecordSet{
+				Name:    name,
+				Type:    recordType,
+				TTL:     ttl,
+				Records: make([]string, 0),
+			}
+			sets[key] = set
+		}
+
+		// a Record Set only has a single TTL, so use the lowest of those specified
+		if ttl < set.TTL {
+			set.TTL = ttl
+		}
+
+		if !containsString(set.Records, record) {
+			set.Records = append(set.Records, record)
+		}
+
+		if recordType == "CNAME" && len(set.Records) > 1 {
+			return nil, fmt.Errorf("line %d: %q can only have a single CNAME record", l.number, name)
+		}
+
+		// a CNAME record can't coexist with any other records for the same name
+		for _, t := range typesByName[name] {
+			if t != recordType && (t == "CNAME" || recordType == "CNAME") {
+				return nil, fmt.Errorf("line %d: %q has both a CNAME record and a %s record", l.number, name, otherType(t, recordType))
+			}
+		}
+		if !exists {
+			typesByName[name] = append(typesByName[name], recordType)
+		}
+	}
+
+	results := make([]RecordSet, 0, len(sets))
+	for _, set := range sets {
+		sort.Strings(set.Records)
+		results = append(results, *set)
+	}
+	Sort(results)
+
+	return results, nil
+}
+
+// Render writes the record sets for the zone `origin` out as a zone file which can be read by Parse.
+func Render(origin string, sets []RecordSet) string {
+	var buf bytes.Buffer
+
+	buf.WriteString(fmt.Sprintf("$ORIGIN %s\n", absoluteName(origin, ".")))
+
+	for _, set := range sets {
+		for _, record := range set.Records {
+			buf.WriteString(fmt.Sprintf("%s %d IN %s %s\n", set.Name, set.TTL, set.Type, renderRecordData(set.Type, record)))
+		}
+	}
+
+	return buf.String()
+}
+
+// Sort orders the record sets by name and then type, and is applied to the output of Parse.
+func Sort(sets []RecordSet) {
+	sort.Slice(sets, func(i, j int) bool {
+		if sets[i].Name != sets[j].Name {
+			return sets[i].Name < sets[j].Name
+		}
+		return sets[i].Type < sets[j].Type
+	})
+}
+
+// Equal returns whether the two record sets have the same name, type, TTL and records.
+func Equal(a RecordSet, b RecordSet) bool {
+	if a.Name != b.Name || a.Type != b.Type || a.TTL != b.TTL || len(a.Records) != len(b.Records) {
+		return false
+	}
+
+	for i := range a.Records {
+		if a.Records[i] != b.Records[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func parseRecordData(recordType string, rdata []token, origin string) (string, error) {
+	expectFields := func(count int) error {
+		if len(rdata) != count {
+			return fmt.Errorf("expected %d fields for a %s record but got %d", count, recordType, len(rdata))
+		}
+		return nil
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		if err := expectFields(1); err != nil {
+			return "", err
+		}
+		ip := net.ParseIP(rdata[0].value)
+		if ip == nil || (recordType == "A") != (ip.To4() != nil) {
+			return "", fmt.Errorf("%q is not a valid address for a %s record", rdata[0].value, recordType)
+		}
+		return ip.String(), nil
+
+	case "CNAME", "NS", "PTR":
+		if err := expectFields(1); err != nil {
+			return "", err
+		}
+		return targetName(rdata[0].value, origin)
+
+	case "MX":
+		if err := expectFields(2); err != nil {
+			return "", err
+		}
+		preference, err := parseUint16("preference", rdata[0].value)
+		if err != nil {
+			return "", err
+		}
+		exchange, err := targetName(rdata[1].value, origin)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d %s", preference, exchange), nil
+
+	case "SRV":
+		if err := expectFields(4); err != nil {
+			return "", err
+		}
+		values := make([]uint64, 0, 3)
+		for i, field := range []string{"priority", "weight", "port"} {
+			v, err := parseUint16(field, rdata[i].value)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, v)
+		}
+		target, err := targetName(rdata[3].value, origin)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%d %d %d %s", values[0], values[1], values[2], target), nil
+
+	case "TXT":
+		if len(rdata) == 0 {
+			return "", fmt.Errorf("expected at least one string for a TXT record")
+		}
+		// adjacent quoted strings are concatenated, whereas unquoted words are separate
+		// character-strings and so are kept apart with a space
+		var buf bytes.Buffer
+		for i, t := range rdata {
+			if i > 0 && (!t.quoted || !rdata[i-1].quoted) {
+				buf.WriteByte(' ')
+			}
+			buf.WriteString(t.value)
+		}
+		return buf.String(), nil
+	}
+
+	return "", fmt.Errorf("records of type %q are not supported", recordType)
+}
+
+func renderRecordData(recordType string, record string) string {
+	switch recordType {
+	case "CNAME", "NS", "PTR", "MX", "SRV":
+		// the target is always the last field and is rendered fully qualified
+		return record + "."
+
+	case "TXT":
+		var buf bytes.Buffer
+		buf.WriteByte('"')
+		for _, c := range []byte(record) {
+			switch {
+			case c == '"' || c == '\\':
+				buf.WriteByte('\\')
+				buf.WriteByte(c)
+			case c < 32 || c > 126:
+				buf.WriteString(fmt.Sprintf("\\%03d", c))
+			default:
+				buf.WriteByte(c)
+			}
+		}
+		buf.WriteByte('"')
+		return buf.String()
+	}
+
+	return record
+}
+
+func parseTTL(input string) (int64, error) {
+	if input == "" {
+		return 0, fmt.Errorf("expected a TTL but got an empty value")
+	}
+
+	if v, err := strconv.ParseInt(input, 10, 32); err == nil {
+		if v < 0 {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		return v, nil
+	}
+
+	units := map[byte]int64{
+		'W': 604800,
+		'D': 86400,
+		'H': 3600,
+		'M': 60,
+		'S': 1,
+	}
+
+	total := int64(0)
+	digits := ""
+	for _, c := range []byte(strings.ToUpper(input)) {
+		if c >= '0' && c <= '9' {
+			digits += string(c)
+			continue
+		}
+
+		multiplier, ok := units[c]
+		if !ok || digits == "" {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+
+		v, err := strconv.ParseInt(digits, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("%q is not a valid TTL", input)
+		}
+		total += v * multiplier
+		digits = ""
+	}
+
+	if digits != "" || total > 2147483647 {
+		return 0, fmt.Errorf("%q is not a valid TTL", input)
+	}
+
+	return total, nil
+}
+
+func parseUint16(field string, input string) (uint64, error) {
+	v, err := strconv.ParseUint(input, 10, 16)
+	if err != nil {
+		return 0, fmt.Errorf("%q is not a valid %s", input, field)
+	}
+	return v, nil
+}
+
+func absoluteName(name string, origin string) string {
+	name = strings.ToLower(name)
+
+	if name == "@" {
+		return origin
+	}
+
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	if origin == "." {
+		return name + "."
+	}
+
+	return name + "." + origin
+}
+
+func relativeName(name string, zone string) (string, error) {
+	if name == zone {
+		return "@", nil
+	}
+
+	if strings.HasSuffix(name, "."+zone) {
+		return strings.TrimSuffix(name, "."+zone), nil
+	}
+
+	return "", fmt.Errorf("%q is not within the zone %q", name, zone)
+}
+
+func targetName(name string, origin string) (string, error) {
+	target := strings.TrimSuffix(absoluteName(name, origin), ".")
+	if target == "" {
+		// this includes a "null" MX record (RFC 7505), which Azure DNS doesn't support
+		return "", fmt.Errorf("the root domain (\".\") isn't supported as a target")
+	}
+	return target, nil
+}
+
+func otherType(a string, b string) string {
+	if a == "CNAME" {
+		return b
+	}
+	return a
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// tokenize splits the zone file into logical lines of tokens, removing comments and joining
+// lines which are continued using parentheses.
+func tokenize(input string) ([]line, error) {
+	lines := make([]line, 0)
+	current := line{}
+	lineNumber := 1
+	depth := 0
+	atLineStart := true
+
+	var buf bytes.Buffer
+	inToken := false
+	inQuotes := false
+
+	flush := func(quoted bool) {
+		if !inToken && !quoted {
+			return
+		}
+		if current.number == 0 {
+			current.number = lineNumber
+		}
+		current.tokens = append(current.tokens, token{
+			value:  buf.String(),
+			quoted: quoted,
+		})
+		buf.Reset()
+		inToken = false
+	}
+
+	for i := 0; i < len(input); i++ {
+		c := input[i]
+
+		if c == '\\' {
+			if i+1 >= len(input) {
+				return nil, fmt.Errorf("line %d: unexpected end of input after escape character", lineNumber)
+			}
+
+			// `\DDD` is the octet with the decimal value DDD, otherwise `\X` is the literal character X
+			if i+3 < len(input) && isDigit(input[i+1]) && isDigit(input[i+2]) && isDigit(input[i+3]) {
+				v, _ := strconv.Atoi(input[i+1 : i+4])
+				if v > 255 {
+					return nil, fmt.Errorf("line %d: invalid escape sequence %q", lineNumber, input[i:i+4])
+				}
+				buf.WriteByte(byte(v))
+				i += 3
+			} else {
+				buf.WriteByte(input[i+1])
+				if input[i+1] == '\n' {
+					lineNumber++
+				}
+				i++
+			}
+			inToken = true
+			atLineStart = false
+			continue
+		}
+
+		if inQuotes {
+			switch c {
+			case '"':
+				inQuotes = false
+				flush(true)
+			case '\n':
+				return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+			default:
+				buf.WriteByte(c)
+			}
+			continue
+		}
+
+		switch c {
+		case ';':
+			for i+1 < len(input) && input[i+1] != '\n' {
+				i++
+			}
+		case '"':
+			flush(false)
+			inQuotes = true
+		case '(':
+			flush(false)
+			depth++
+		case ')':
+			flush(false)
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("line %d: unexpected closing parenthesis", lineNumber)
+			}
+		case ' ', '\t', '\r':
+			if atLineStart && depth == 0 && len(current.tokens) == 0 {
+				current.inheritsOwner = true
+			}
+			flush(false)
+		case '\n':
+			flush(false)
+			lineNumber++
+			atLineStart = true
+			if depth == 0 {
+				if len(current.tokens) > 0 {
+					lines = append(lines, current)
+				}
+				current = line{}
+			}
+			continue
+		default:
+			buf.WriteByte(c)
+			inToken = true
+		}
+
+		atLineStart = false
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unterminated parenthesis", lineNumber)
+	}
+
+	flush(false)
+	if len(current.tokens) > 0 {
+		lines = append(lines, current)
+	}
+
+	return lines, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
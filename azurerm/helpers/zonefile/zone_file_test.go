@@ -0,0 +1,233 @@
+package zonefile
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		sourceFile string
+		origin     string
+		expected   []RecordSet
+	}{
+		{
+			"example.com.zone",
+			"example.com",
+			[]RecordSet{
+				{Name: "10.2.0.192.in-addr.arpa", Type: "PTR", TTL: 3600, Records: []string{"example.com"}},
+				{Name: "@", Type: "A", TTL: 300, Records: []string{"192.0.2.10", "192.0.2.11"}},
+				{Name: "@", Type: "MX", TTL: 3600, Records: []string{"10 mail.example.com", "20 mail.backup.example.net"}},
+				{Name: "@", Type: "TXT", TTL: 3600, Records: []string{"v=spf1 include:spf.example.net ~all"}},
+				{Name: "_sip._tcp", Type: "SRV", TTL: 3600, Records: []string{"10 60 5060 sip.example.com"}},
+				{Name: "long", Type: "TXT", TTL: 3600, Records: []string{"first part second part"}},
+				{Name: "mail", Type: "A", TTL: 86400, Records: []string{"192.0.2.25"}},
+				{Name: "mail", Type: "AAAA", TTL: 3600, Records: []string{"2001:db8::25"}},
+				{Name: "quoted", Type: "TXT", TTL: 3600, Records: []string{`a "quoted" value; not a comment`}},
+				{Name: "sip", Type: "A", TTL: 600, Records: []string{"192.0.2.50"}},
+				{Name: "sub", Type: "NS", TTL: 3600, Records: []string{"ns1.sub.example.com"}},
+				{Name: "www", Type: "CNAME", TTL: 3600, Records: []string{"example.com"}},
+			},
+		},
+		{
+			"relative.zone",
+			"example.com.",
+			[]RecordSet{
+				{Name: "api", Type: "A", TTL: 300, Records: []string{"198.51.100.1"}},
+				{Name: "db.internal", Type: "A", TTL: 300, Records: []string{"10.0.0.4"}},
+			},
+		},
+	}
+
+	for _, test := range testCases {
+		input, err := ioutil.ReadFile(filepath.Join("testdata", test.sourceFile))
+		if err != nil {
+			t.Fatalf("Error reading %q: %+v", test.sourceFile, err)
+		}
+
+		actual, err := Parse(string(input), test.origin)
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", test.sourceFile, err)
+		}
+
+		if !reflect.DeepEqual(test.expected, actual) {
+			t.Fatalf("Expected %q to parse to:\n%+v\nbut got:\n%+v", test.sourceFile, test.expected, actual)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		errorOn string
+	}{
+		{
+			name:    "unsupported record type",
+			input:   "@ 3600 IN CAA 0 issue \"letsencrypt.org\"",
+			errorOn: `records of type "CAA" are not supported`,
+		},
+		{
+			name:    "unsupported directive",
+			input:   "$INCLUDE other.zone",
+			errorOn: "the $INCLUDE directive is not supported",
+		},
+		{
+			name:    "unsupported class",
+			input:   "@ 3600 CH A 192.0.2.1",
+			errorOn: "the CH class is not supported",
+		},
+		{
+			name:    "name outside of the zone",
+			input:   "www.example.net. 3600 IN A 192.0.2.1",
+			errorOn: `"www.example.net." is not within the zone "example.com."`,
+		},
+		{
+			name:    "invalid IPv4 address",
+			input:   "www 3600 IN A 2001:db8::1",
+			errorOn: `"2001:db8::1" is not a valid address for a A record`,
+		},
+		{
+			name:    "multiple CNAME records",
+			input:   "www IN CNAME a.example.net.\nwww IN CNAME b.example.net.",
+			errorOn: `line 2: "www" can only have a single CNAME record`,
+		},
+		{
+			name:    "CNAME record alongside another type",
+			input:   "www IN CNAME a.example.net.\nwww IN A 192.0.2.1",
+			errorOn: `line 2: "www" has both a CNAME record and a A record`,
+		},
+		{
+			name:    "another type alongside a CNAME record",
+			input:   "www IN TXT \"hello\"\nwww IN CNAME a.example.net.",
+			errorOn: `line 2: "www" has both a CNAME record and a TXT record`,
+		},
+		{
+			name:    "null MX record",
+			input:   "@ IN MX 0 .",
+			errorOn: `the root domain (".") isn't supported as a target`,
+		},
+		{
+			name:    "SRV record targeting the root domain",
+			input:   "_sip._tcp IN SRV 0 0 0 .",
+			errorOn: `the root domain (".") isn't supported as a target`,
+		},
+		{
+			name:    "missing fields",
+			input:   "@ IN MX mail",
+			errorOn: "expected 2 fields for a MX record but got 1",
+		},
+		{
+			name:    "no previous owner",
+			input:   "  IN A 192.0.2.1",
+			errorOn: "no owner name was specified",
+		},
+		{
+			name:    "unterminated quoted string",
+			input:   "@ IN TXT \"unterminated\n",
+			errorOn: "line 1: unterminated quoted string",
+		},
+		{
+			name:    "unterminated parenthesis",
+			input:   "@ IN TXT ( \"first\"\n\"second\"",
+			errorOn: "unterminated parenthesis",
+		},
+	}
+
+	for _, test := range testCases {
+		_, err := Parse(test.input, "example.com")
+		if err == nil {
+			t.Fatalf("Expected an error parsing %q but didn't get one", test.name)
+		}
+
+		if !strings.Contains(err.Error(), test.errorOn) {
+			t.Fatalf("Expected the error for %q to contain %q but got %q", test.name, test.errorOn, err.Error())
+		}
+	}
+}
+
+func TestParseTXT(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected string
+	}{
+		{input: `@ IN TXT hello`, expected: "hello"},
+		{input: `@ IN TXT hello world`, expected: "hello world"},
+		{input: `@ IN TXT "hello world"`, expected: "hello world"},
+		{input: `@ IN TXT "hello " "world"`, expected: "hello world"},
+		{input: `@ IN TXT "hello" world`, expected: "hello world"},
+		{input: `@ IN TXT "a\"b" c\;d`, expected: `a"b c;d`},
+	}
+
+	for _, test := range testCases {
+		sets, err := Parse(test.input, "example.com")
+		if err != nil {
+			t.Fatalf("Error parsing %q: %+v", test.input, err)
+		}
+
+		if len(sets) != 1 || len(sets[0].Records) != 1 {
+			t.Fatalf("Expected %q to parse to a single TXT record but got %+v", test.input, sets)
+		}
+
+		if actual := sets[0].Records[0]; actual != test.expected {
+			t.Fatalf("Expected %q to parse to %q but got %q", test.input, test.expected, actual)
+		}
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	testCases := []struct {
+		input    string
+		expected int64
+		valid    bool
+	}{
+		{input: "0", expected: 0, valid: true},
+		{input: "3600", expected: 3600, valid: true},
+		{input: "1h", expected: 3600, valid: true},
+		{input: "1H30M", expected: 5400, valid: true},
+		{input: "1w2d", expected: 777600, valid: true},
+		{input: "", valid: false},
+		{input: "-1", valid: false},
+		{input: "1h30", valid: false},
+		{input: "1y", valid: false},
+		{input: "A", valid: false},
+	}
+
+	for _, test := range testCases {
+		actual, err := parseTTL(test.input)
+		if test.valid && err != nil {
+			t.Fatalf("Expected %q to be a valid TTL but got: %+v", test.input, err)
+		}
+		if !test.valid && err == nil {
+			t.Fatalf("Expected %q to be an invalid TTL but it parsed to %d", test.input, actual)
+		}
+		if test.valid && actual != test.expected {
+			t.Fatalf("Expected %q to parse to %d but got %d", test.input, test.expected, actual)
+		}
+	}
+}
+
+func TestRenderRoundTrip(t *testing.T) {
+	input, err := ioutil.ReadFile(filepath.Join("testdata", "example.com.zone"))
+	if err != nil {
+		t.Fatalf("Error reading the sample zone file: %+v", err)
+	}
+
+	expected, err := Parse(string(input), "example.com")
+	if err != nil {
+		t.Fatalf("Error parsing the sample zone file: %+v", err)
+	}
+
+	rendered := Render("example.com", expected)
+	actual, err := Parse(rendered, "example.com")
+	if err != nil {
+		t.Fatalf("Error parsing the rendered zone file: %+v\n%s", err, rendered)
+	}
+
+	if !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("Expected the rendered zone file to parse to:\n%+v\nbut got:\n%+v", expected, actual)
+	}
+}
@@ -1,12 +1,16 @@
 package azurerm
 
+import "sort"
+
 // handle the case of using the same name for different kinds of resources
 func azureRMLockByName(name string, resourceType string) {
 	updatedName := resourceType + "." + name
 	armMutexKV.Lock(updatedName)
 }
 
+// locks are always taken in the same (sorted) order to avoid deadlocks between resources locking the same names
 func azureRMLockMultipleByName(names *[]string, resourceType string) {
+	sort.Strings(*names)
 	for _, name := range *names {
 		azureRMLockByName(name, resourceType)
 	}
@@ -0,0 +1,299 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Associations between an IP Configuration and another resource don't have an ID of their own,
+// so we combine the ID of the IP Configuration and the ID of the associated resource
+const networkInterfaceAssociationIdSeparator = "|"
+
+type networkInterfaceAssociationId struct {
+	ResourceGroup        string
+	NetworkInterfaceName string
+	IPConfigurationName  string
+	NetworkInterfaceId   string
+	AssociatedResourceId string
+	IPConfigurationId    string
+}
+
+func parseNetworkInterfaceAssociationId(input string) (*networkInterfaceAssociationId, error) {
+	segments := strings.Split(input, networkInterfaceAssociationIdSeparator)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return nil, fmt.Errorf("Expected the ID to be in the format `{ipConfigurationId}%s{associatedResourceId}` but got %q", networkInterfaceAssociationIdSeparator, input)
+	}
+
+	ipConfigurationId := segments[0]
+	id, err := parseAzureResourceID(ipConfigurationId)
+	if err != nil {
+		return nil, err
+	}
+
+	networkInterfaceName := id.Path["networkInterfaces"]
+	ipConfigurationName := id.Path["ipConfigurations"]
+	if networkInterfaceName == "" || ipConfigurationName == "" {
+		return nil, fmt.Errorf("Expected %q to be the ID of an IP Configuration within a Network Interface", ipConfigurationId)
+	}
+
+	return &networkInterfaceAssociationId{
+		ResourceGroup:        id.ResourceGroup,
+		NetworkInterfaceName: networkInterfaceName,
+		IPConfigurationName:  ipConfigurationName,
+		NetworkInterfaceId:   strings.TrimSuffix(ipConfigurationId, fmt.Sprintf("/ipConfigurations/%s", ipConfigurationName)),
+		IPConfigurationId:    ipConfigurationId,
+		AssociatedResourceId: segments[1],
+	}, nil
+}
+
+// findNetworkInterfaceIPConfigurationByName returns a pointer into the Network Interface's list of
+// IP Configurations, so that changes made to it are sent when the Network Interface is updated
+func findNetworkInterfaceIPConfigurationByName(iface *network.Interface, name string) *network.InterfaceIPConfiguration {
+	if iface == nil || iface.InterfacePropertiesFormat == nil || iface.InterfacePropertiesFormat.IPConfigurations == nil {
+		return nil
+	}
+
+	configs := *iface.InterfacePropertiesFormat.IPConfigurations
+	for i := range configs {
+		if configs[i].Name != nil && strings.EqualFold(*configs[i].Name, name) {
+			if configs[i].InterfaceIPConfigurationPropertiesFormat == nil {
+				configs[i].InterfaceIPConfigurationPropertiesFormat = &network.InterfaceIPConfigurationPropertiesFormat{}
+			}
+			return &configs[i]
+		}
+	}
+
+	return nil
+}
+
+func updateNetworkInterfaceForAssociation(iface network.Interface, resourceGroup string, name string, meta interface{}) error {
+	client := meta.(*ArmClient).ifaceClient
+	ctx := meta.(*ArmClient).StopContext
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, iface)
+	if err != nil {
+		return fmt.Errorf("Error updating Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for update of Network Interface %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+// networkInterfaceAssociationModifier replaces the IDs of the resources of a given type which are associated
+// with an IP Configuration with the IDs returned by `modify`, which is passed the existing IDs
+type networkInterfaceAssociationModifier func(props *network.InterfaceIPConfigurationPropertiesFormat, modify func(existing []string) []string)
+
+// networkInterfaceAssociation describes an association between an IP Configuration and another resource,
+// which is managed by the shared Create/Read/Delete functions below
+type networkInterfaceAssociation struct {
+	// resourceType is the name of the Terraform resource, e.g. `azurerm_network_interface_nat_rule_association`
+	resourceType string
+
+	// displayName is the type of the associated resource used in messages, e.g. `NAT Rule`
+	displayName string
+
+	// associatedResourceIdKey is the field in the schema containing the ID of the associated resource
+	associatedResourceIdKey string
+
+	modifier networkInterfaceAssociationModifier
+}
+
+// networkInterfaceAssociatedIds returns the IDs of the resources associated with the IP Configuration
+func networkInterfaceAssociatedIds(props *network.InterfaceIPConfigurationPropertiesFormat, modifier networkInterfaceAssociationModifier) []string {
+	ids := make([]string, 0)
+	if props == nil {
+		return ids
+	}
+
+	// the modifier is passed a copy so that `props` is left untouched
+	copied := *props
+	modifier(&copied, func(existing []string) []string {
+		ids = existing
+		return existing
+	})
+
+	return ids
+}
+
+func resourceArmNetworkInterfaceAssociationSchema(associatedResourceIdKey string) map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"network_interface_id": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		"ip_configuration_name": {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+
+		associatedResourceIdKey: {
+			Type:     schema.TypeString,
+			Required: true,
+			ForceNew: true,
+		},
+	}
+}
+
+func resourceArmNetworkInterfaceAssociationCreate(association networkInterfaceAssociation) schema.CreateFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient).ifaceClient
+		ctx := meta.(*ArmClient).StopContext
+
+		log.Printf("[INFO] preparing arguments for Network Interface <-> %s Association creation.", association.displayName)
+
+		networkInterfaceId := d.Get("network_interface_id").(string)
+		ipConfigurationName := d.Get("ip_configuration_name").(string)
+		associatedResourceId := d.Get(association.associatedResourceIdKey).(string)
+
+		id, err := parseAzureResourceID(networkInterfaceId)
+		if err != nil {
+			return err
+		}
+		resourceGroup := id.ResourceGroup
+		networkInterfaceName := id.Path["networkInterfaces"]
+
+		azureRMLockByName(networkInterfaceName, networkInterfaceResourceName)
+		defer azureRMUnlockByName(networkInterfaceName, networkInterfaceResourceName)
+
+		read, err := client.Get(ctx, resourceGroup, networkInterfaceName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				return fmt.Errorf("Network Interface %q (Resource Group %q) was not found!", networkInterfaceName, resourceGroup)
+			}
+
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", networkInterfaceName, resourceGroup, err)
+		}
+
+		config := findNetworkInterfaceIPConfigurationByName(&read, ipConfigurationName)
+		if config == nil {
+			return fmt.Errorf("IP Configuration %q was not found on Network Interface %q (Resource Group %q)", ipConfigurationName, networkInterfaceName, resourceGroup)
+		}
+		if config.ID == nil {
+			return fmt.Errorf("Cannot read IP Configuration %q (Network Interface %q / Resource Group %q) ID", ipConfigurationName, networkInterfaceName, resourceGroup)
+		}
+
+		resourceId := fmt.Sprintf("%s%s%s", *config.ID, networkInterfaceAssociationIdSeparator, associatedResourceId)
+
+		for _, v := range networkInterfaceAssociatedIds(config.InterfaceIPConfigurationPropertiesFormat, association.modifier) {
+			if strings.EqualFold(v, associatedResourceId) {
+				return fmt.Errorf("A resource with the ID %q already exists - to be managed via Terraform this resource needs to be imported into the State. Please see the resource documentation for %q for more information.", resourceId, association.resourceType)
+			}
+		}
+
+		association.modifier(config.InterfaceIPConfigurationPropertiesFormat, func(existing []string) []string {
+			return append(existing, associatedResourceId)
+		})
+
+		err = updateNetworkInterfaceForAssociation(read, resourceGroup, networkInterfaceName, meta)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(resourceId)
+
+		return resourceArmNetworkInterfaceAssociationRead(association)(d, meta)
+	}
+}
+
+func resourceArmNetworkInterfaceAssociationRead(association networkInterfaceAssociation) schema.ReadFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient).ifaceClient
+		ctx := meta.(*ArmClient).StopContext
+
+		id, err := parseNetworkInterfaceAssociationId(d.Id())
+		if err != nil {
+			return err
+		}
+
+		read, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				log.Printf("[DEBUG] Network Interface %q (Resource Group %q) was not found - removing from state", id.NetworkInterfaceName, id.ResourceGroup)
+				d.SetId("")
+				return nil
+			}
+
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", id.NetworkInterfaceName, id.ResourceGroup, err)
+		}
+
+		config := findNetworkInterfaceIPConfigurationByName(&read, id.IPConfigurationName)
+		if config == nil {
+			log.Printf("[DEBUG] IP Configuration %q was not found on Network Interface %q (Resource Group %q) - removing from state", id.IPConfigurationName, id.NetworkInterfaceName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		found := false
+		for _, v := range networkInterfaceAssociatedIds(config.InterfaceIPConfigurationPropertiesFormat, association.modifier) {
+			if strings.EqualFold(v, id.AssociatedResourceId) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			log.Printf("[DEBUG] Association between Network Interface %q (Resource Group %q) and %s %q was not found - removing from state", id.NetworkInterfaceName, id.ResourceGroup, association.displayName, id.AssociatedResourceId)
+			d.SetId("")
+			return nil
+		}
+
+		d.Set("network_interface_id", read.ID)
+		d.Set("ip_configuration_name", id.IPConfigurationName)
+		d.Set(association.associatedResourceIdKey, id.AssociatedResourceId)
+
+		return nil
+	}
+}
+
+func resourceArmNetworkInterfaceAssociationDelete(association networkInterfaceAssociation) schema.DeleteFunc {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		client := meta.(*ArmClient).ifaceClient
+		ctx := meta.(*ArmClient).StopContext
+
+		id, err := parseNetworkInterfaceAssociationId(d.Id())
+		if err != nil {
+			return err
+		}
+
+		azureRMLockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+		defer azureRMUnlockByName(id.NetworkInterfaceName, networkInterfaceResourceName)
+
+		read, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, "")
+		if err != nil {
+			if utils.ResponseWasNotFound(read.Response) {
+				return nil
+			}
+
+			return fmt.Errorf("Error retrieving Network Interface %q (Resource Group %q): %+v", id.NetworkInterfaceName, id.ResourceGroup, err)
+		}
+
+		config := findNetworkInterfaceIPConfigurationByName(&read, id.IPConfigurationName)
+		if config == nil {
+			return nil
+		}
+
+		association.modifier(config.InterfaceIPConfigurationPropertiesFormat, func(existing []string) []string {
+			ids := make([]string, 0)
+			for _, v := range existing {
+				if !strings.EqualFold(v, id.AssociatedResourceId) {
+					ids = append(ids, v)
+				}
+			}
+			return ids
+		})
+
+		return updateNetworkInterfaceForAssociation(read, id.ResourceGroup, id.NetworkInterfaceName, meta)
+	}
+}
@@ -0,0 +1,205 @@
+package azurerm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseNetworkInterfaceAssociationId(t *testing.T) {
+	nicId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkInterfaces/nic1"
+	ipConfigId := nicId + "/ipConfigurations/config1"
+	poolId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"
+
+	testCases := []struct {
+		Input       string
+		Expected    *networkInterfaceAssociationId
+		ExpectError bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       ipConfigId,
+			ExpectError: true,
+		},
+		{
+			Input:       ipConfigId + "|",
+			ExpectError: true,
+		},
+		{
+			Input:       nicId + "|" + poolId,
+			ExpectError: true,
+		},
+		{
+			Input: ipConfigId + "|" + poolId,
+			Expected: &networkInterfaceAssociationId{
+				ResourceGroup:        "group1",
+				NetworkInterfaceName: "nic1",
+				IPConfigurationName:  "config1",
+				NetworkInterfaceId:   nicId,
+				IPConfigurationId:    ipConfigId,
+				AssociatedResourceId: poolId,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		actual, err := parseNetworkInterfaceAssociationId(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if *actual != *tc.Expected {
+			t.Fatalf("Expected %+v but got %+v", *tc.Expected, *actual)
+		}
+	}
+}
+
+func TestNetworkInterfaceAssociatedIds(t *testing.T) {
+	poolId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/loadBalancers/lb1/backendAddressPools/pool1"
+	pools := []network.BackendAddressPool{
+		{ID: utils.String(poolId)},
+		{},
+	}
+	props := &network.InterfaceIPConfigurationPropertiesFormat{
+		LoadBalancerBackendAddressPools: &pools,
+	}
+
+	actual := networkInterfaceAssociatedIds(props, modifyNetworkInterfaceBackendAddressPoolAssociations)
+	if len(actual) != 1 || actual[0] != poolId {
+		t.Fatalf("Expected the IDs to be [%q] but got %+v", poolId, actual)
+	}
+
+	if props.LoadBalancerBackendAddressPools != &pools || len(*props.LoadBalancerBackendAddressPools) != 2 {
+		t.Fatalf("Expected the IP Configuration to be left unchanged but got %+v", *props.LoadBalancerBackendAddressPools)
+	}
+
+	if actual := networkInterfaceAssociatedIds(nil, modifyNetworkInterfaceBackendAddressPoolAssociations); len(actual) != 0 {
+		t.Fatalf("Expected no IDs for a nil IP Configuration but got %+v", actual)
+	}
+}
+
+// testCheckAzureRMNetworkInterfaceAssociationExists checks that the associated resource is associated with the
+// IP Configuration referenced in the Association's ID, using `modifier` to read the associated IDs
+func testCheckAzureRMNetworkInterfaceAssociationExists(name string, modifier networkInterfaceAssociationModifier) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		exists, err := testGetAzureRMNetworkInterfaceAssociation(rs.Primary.ID, modifier)
+		if err != nil {
+			return err
+		}
+
+		if !exists {
+			return fmt.Errorf("Bad: Association %q does not exist", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkInterfaceAssociationDestroy(resourceType string, modifier networkInterfaceAssociationModifier) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+
+			exists, err := testGetAzureRMNetworkInterfaceAssociation(rs.Primary.ID, modifier)
+			if err != nil {
+				return err
+			}
+
+			if exists {
+				return fmt.Errorf("Association %q still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testGetAzureRMNetworkInterfaceAssociation(resourceId string, modifier networkInterfaceAssociationModifier) (bool, error) {
+	client := testAccProvider.Meta().(*ArmClient).ifaceClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	id, err := parseNetworkInterfaceAssociationId(resourceId)
+	if err != nil {
+		return false, err
+	}
+
+	read, err := client.Get(ctx, id.ResourceGroup, id.NetworkInterfaceName, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(read.Response) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("Bad: Get on ifaceClient: %+v", err)
+	}
+
+	config := findNetworkInterfaceIPConfigurationByName(&read, id.IPConfigurationName)
+	if config == nil {
+		return false, nil
+	}
+
+	for _, v := range networkInterfaceAssociatedIds(config.InterfaceIPConfigurationPropertiesFormat, modifier) {
+		if strings.EqualFold(v, id.AssociatedResourceId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func testAccAzureRMNetworkInterfaceAssociation_template(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_network_interface" "test" {
+  name                = "acctestni-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  ip_configuration {
+    name                          = "testconfiguration1"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+`, rInt, location, rInt, rInt)
+}
@@ -77,37 +77,45 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"azurerm_application_security_group":            dataSourceArmApplicationSecurityGroup(),
-			"azurerm_app_service":                           dataSourceArmAppService(),
-			"azurerm_app_service_plan":                      dataSourceAppServicePlan(),
-			"azurerm_builtin_role_definition":               dataSourceArmBuiltInRoleDefinition(),
-			"azurerm_cdn_profile":                           dataSourceArmCdnProfile(),
-			"azurerm_client_config":                         dataSourceArmClientConfig(),
-			"azurerm_cosmosdb_account":                      dataSourceArmCosmosDBAccount(),
-			"azurerm_dns_zone":                              dataSourceArmDnsZone(),
-			"azurerm_eventhub_namespace":                    dataSourceEventHubNamespace(),
-			"azurerm_image":                                 dataSourceArmImage(),
-			"azurerm_key_vault_access_policy":               dataSourceArmKeyVaultAccessPolicy(),
-			"azurerm_kubernetes_cluster":                    dataSourceArmKubernetesCluster(),
-			"azurerm_managed_disk":                          dataSourceArmManagedDisk(),
-			"azurerm_network_interface":                     dataSourceArmNetworkInterface(),
-			"azurerm_network_security_group":                dataSourceArmNetworkSecurityGroup(),
-			"azurerm_platform_image":                        dataSourceArmPlatformImage(),
-			"azurerm_public_ip":                             dataSourceArmPublicIP(),
-			"azurerm_public_ips":                            dataSourceArmPublicIPs(),
-			"azurerm_recovery_services_vault":               dataSourceArmRecoveryServicesVault(),
-			"azurerm_resource_group":                        dataSourceArmResourceGroup(),
-			"azurerm_role_definition":                       dataSourceArmRoleDefinition(),
-			"azurerm_route_table":                           dataSourceArmRouteTable(),
-			"azurerm_scheduler_job_collection":              dataSourceArmSchedulerJobCollection(),
-			"azurerm_snapshot":                              dataSourceArmSnapshot(),
-			"azurerm_storage_account":                       dataSourceArmStorageAccount(),
-			"azurerm_subnet":                                dataSourceArmSubnet(),
-			"azurerm_subscription":                          dataSourceArmSubscription(),
-			"azurerm_subscriptions":                         dataSourceArmSubscriptions(),
-			"azurerm_traffic_manager_geographical_location": dataSourceArmTrafficManagerGeographicalLocation(),
-			"azurerm_virtual_network":                       dataSourceArmVirtualNetwork(),
-			"azurerm_virtual_network_gateway":               dataSourceArmVirtualNetworkGateway(),
+			"azurerm_application_security_group":                 dataSourceArmApplicationSecurityGroup(),
+			"azurerm_app_service":                                dataSourceArmAppService(),
+			"azurerm_app_service_plan":                           dataSourceAppServicePlan(),
+			"azurerm_bgp_service_communities":                    dataSourceArmBgpServiceCommunities(),
+			"azurerm_builtin_role_definition":                    dataSourceArmBuiltInRoleDefinition(),
+			"azurerm_cdn_profile":                                dataSourceArmCdnProfile(),
+			"azurerm_client_config":                              dataSourceArmClientConfig(),
+			"azurerm_cosmosdb_account":                           dataSourceArmCosmosDBAccount(),
+			"azurerm_dns_zone":                                   dataSourceArmDnsZone(),
+			"azurerm_eventhub_namespace":                         dataSourceEventHubNamespace(),
+			"azurerm_image":                                      dataSourceArmImage(),
+			"azurerm_key_vault_access_policy":                    dataSourceArmKeyVaultAccessPolicy(),
+			"azurerm_kubernetes_cluster":                         dataSourceArmKubernetesCluster(),
+			"azurerm_managed_disk":                               dataSourceArmManagedDisk(),
+			"azurerm_network_interface":                          dataSourceArmNetworkInterface(),
+			"azurerm_network_interface_effective_routes":         dataSourceArmNetworkInterfaceEffectiveRoutes(),
+			"azurerm_network_interface_effective_security_rules": dataSourceArmNetworkInterfaceEffectiveSecurityRules(),
+			"azurerm_network_security_group":                     dataSourceArmNetworkSecurityGroup(),
+			"azurerm_network_watcher_ip_flow_verify":             dataSourceArmNetworkWatcherIPFlowVerify(),
+			"azurerm_network_watcher_next_hop":                   dataSourceArmNetworkWatcherNextHop(),
+			"azurerm_platform_image":                             dataSourceArmPlatformImage(),
+			"azurerm_public_ip":                                  dataSourceArmPublicIP(),
+			"azurerm_public_ips":                                 dataSourceArmPublicIPs(),
+			"azurerm_recovery_services_vault":                    dataSourceArmRecoveryServicesVault(),
+			"azurerm_resource_group":                             dataSourceArmResourceGroup(),
+			"azurerm_role_definition":                            dataSourceArmRoleDefinition(),
+			"azurerm_route_table":                                dataSourceArmRouteTable(),
+			"azurerm_scheduler_job_collection":                   dataSourceArmSchedulerJobCollection(),
+			"azurerm_snapshot":                                   dataSourceArmSnapshot(),
+			"azurerm_storage_account":                            dataSourceArmStorageAccount(),
+			"azurerm_subnet":                                     dataSourceArmSubnet(),
+			"azurerm_subscription":                               dataSourceArmSubscription(),
+			"azurerm_subscriptions":                              dataSourceArmSubscriptions(),
+			"azurerm_traffic_manager_geographical_location":      dataSourceArmTrafficManagerGeographicalLocation(),
+			"azurerm_virtual_machine_boot_diagnostics":           dataSourceArmVirtualMachineBootDiagnostics(),
+			"azurerm_virtual_network":                            dataSourceArmVirtualNetwork(),
+			"azurerm_virtual_network_gateway":                    dataSourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_gateway_connection":         dataSourceArmVirtualNetworkGatewayConnection(),
+			"azurerm_virtual_network_gateway_vpn_client_package": dataSourceArmVirtualNetworkGatewayVpnClientPackage(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -139,6 +147,7 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_dns_srv_record":                      resourceArmDnsSrvRecord(),
 			"azurerm_dns_txt_record":                      resourceArmDnsTxtRecord(),
 			"azurerm_dns_zone":                            resourceArmDnsZone(),
+			"azurerm_dns_zone_records":                    resourceArmDnsZoneRecords(),
 			"azurerm_eventgrid_topic":                     resourceArmEventGridTopic(),
 			"azurerm_eventhub":                            resourceArmEventHub(),
 			"azurerm_eventhub_authorization_rule":         resourceArmEventHubAuthorizationRule(),
@@ -172,57 +181,64 @@ func Provider() terraform.ResourceProvider {
 			"azurerm_mysql_firewall_rule":                 resourceArmMySqlFirewallRule(),
 			"azurerm_mysql_server":                        resourceArmMySqlServer(),
 			"azurerm_network_interface":                   resourceArmNetworkInterface(),
-			"azurerm_network_security_group":              resourceArmNetworkSecurityGroup(),
-			"azurerm_network_security_rule":               resourceArmNetworkSecurityRule(),
-			"azurerm_network_watcher":                     resourceArmNetworkWatcher(),
-			"azurerm_packet_capture":                      resourceArmPacketCapture(),
-			"azurerm_policy_assignment":                   resourceArmPolicyAssignment(),
-			"azurerm_policy_definition":                   resourceArmPolicyDefinition(),
-			"azurerm_postgresql_configuration":            resourceArmPostgreSQLConfiguration(),
-			"azurerm_postgresql_database":                 resourceArmPostgreSQLDatabase(),
-			"azurerm_postgresql_firewall_rule":            resourceArmPostgreSQLFirewallRule(),
-			"azurerm_postgresql_server":                   resourceArmPostgreSQLServer(),
-			"azurerm_public_ip":                           resourceArmPublicIp(),
-			"azurerm_recovery_services_vault":             resourceArmRecoveryServicesVault(),
-			"azurerm_redis_cache":                         resourceArmRedisCache(),
-			"azurerm_redis_firewall_rule":                 resourceArmRedisFirewallRule(),
-			"azurerm_resource_group":                      resourceArmResourceGroup(),
-			"azurerm_role_assignment":                     resourceArmRoleAssignment(),
-			"azurerm_role_definition":                     resourceArmRoleDefinition(),
-			"azurerm_route":                               resourceArmRoute(),
-			"azurerm_route_table":                         resourceArmRouteTable(),
-			"azurerm_search_service":                      resourceArmSearchService(),
-			"azurerm_servicebus_namespace":                resourceArmServiceBusNamespace(),
-			"azurerm_servicebus_queue":                    resourceArmServiceBusQueue(),
-			"azurerm_servicebus_subscription":             resourceArmServiceBusSubscription(),
-			"azurerm_servicebus_subscription_rule":        resourceArmServiceBusSubscriptionRule(),
-			"azurerm_servicebus_topic":                    resourceArmServiceBusTopic(),
-			"azurerm_servicebus_topic_authorization_rule": resourceArmServiceBusTopicAuthorizationRule(),
-			"azurerm_snapshot":                            resourceArmSnapshot(),
-			"azurerm_scheduler_job_collection":            resourceArmSchedulerJobCollection(),
-			"azurerm_sql_database":                        resourceArmSqlDatabase(),
-			"azurerm_sql_elasticpool":                     resourceArmSqlElasticPool(),
-			"azurerm_sql_firewall_rule":                   resourceArmSqlFirewallRule(),
-			"azurerm_sql_active_directory_administrator":  resourceArmSqlAdministrator(),
-			"azurerm_sql_server":                          resourceArmSqlServer(),
-			"azurerm_sql_virtual_network_rule":            resourceArmSqlVirtualNetworkRule(),
-			"azurerm_storage_account":                     resourceArmStorageAccount(),
-			"azurerm_storage_blob":                        resourceArmStorageBlob(),
-			"azurerm_storage_container":                   resourceArmStorageContainer(),
-			"azurerm_storage_share":                       resourceArmStorageShare(),
-			"azurerm_storage_queue":                       resourceArmStorageQueue(),
-			"azurerm_storage_table":                       resourceArmStorageTable(),
-			"azurerm_subnet":                              resourceArmSubnet(),
-			"azurerm_template_deployment":                 resourceArmTemplateDeployment(),
-			"azurerm_traffic_manager_endpoint":            resourceArmTrafficManagerEndpoint(),
-			"azurerm_traffic_manager_profile":             resourceArmTrafficManagerProfile(),
-			"azurerm_virtual_machine_extension":           resourceArmVirtualMachineExtensions(),
-			"azurerm_virtual_machine":                     resourceArmVirtualMachine(),
-			"azurerm_virtual_machine_scale_set":           resourceArmVirtualMachineScaleSet(),
-			"azurerm_virtual_network":                     resourceArmVirtualNetwork(),
-			"azurerm_virtual_network_gateway":             resourceArmVirtualNetworkGateway(),
-			"azurerm_virtual_network_gateway_connection":  resourceArmVirtualNetworkGatewayConnection(),
-			"azurerm_virtual_network_peering":             resourceArmVirtualNetworkPeering(),
+			"azurerm_network_interface_application_gateway_backend_address_pool_association": resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation(),
+			"azurerm_network_interface_application_security_group_association":               resourceArmNetworkInterfaceApplicationSecurityGroupAssociation(),
+			"azurerm_network_interface_backend_address_pool_association":                     resourceArmNetworkInterfaceBackendAddressPoolAssociation(),
+			"azurerm_network_interface_nat_rule_association":                                 resourceArmNetworkInterfaceNatRuleAssociation(),
+			"azurerm_network_security_group":                                                 resourceArmNetworkSecurityGroup(),
+			"azurerm_network_security_rule":                                                  resourceArmNetworkSecurityRule(),
+			"azurerm_network_watcher":                                                        resourceArmNetworkWatcher(),
+			"azurerm_network_watcher_flow_log":                                               resourceArmNetworkWatcherFlowLog(),
+			"azurerm_packet_capture":                                                         resourceArmPacketCapture(),
+			"azurerm_policy_assignment":                                                      resourceArmPolicyAssignment(),
+			"azurerm_policy_definition":                                                      resourceArmPolicyDefinition(),
+			"azurerm_postgresql_configuration":                                               resourceArmPostgreSQLConfiguration(),
+			"azurerm_postgresql_database":                                                    resourceArmPostgreSQLDatabase(),
+			"azurerm_postgresql_firewall_rule":                                               resourceArmPostgreSQLFirewallRule(),
+			"azurerm_postgresql_server":                                                      resourceArmPostgreSQLServer(),
+			"azurerm_public_ip":                                                              resourceArmPublicIp(),
+			"azurerm_recovery_services_vault":                                                resourceArmRecoveryServicesVault(),
+			"azurerm_redis_cache":                                                            resourceArmRedisCache(),
+			"azurerm_redis_firewall_rule":                                                    resourceArmRedisFirewallRule(),
+			"azurerm_resource_group":                                                         resourceArmResourceGroup(),
+			"azurerm_role_assignment":                                                        resourceArmRoleAssignment(),
+			"azurerm_role_definition":                                                        resourceArmRoleDefinition(),
+			"azurerm_route":                                                                  resourceArmRoute(),
+			"azurerm_route_filter":                                                           resourceArmRouteFilter(),
+			"azurerm_route_filter_rule":                                                      resourceArmRouteFilterRule(),
+			"azurerm_route_table":                                                            resourceArmRouteTable(),
+			"azurerm_search_service":                                                         resourceArmSearchService(),
+			"azurerm_servicebus_namespace":                                                   resourceArmServiceBusNamespace(),
+			"azurerm_servicebus_queue":                                                       resourceArmServiceBusQueue(),
+			"azurerm_servicebus_subscription":                                                resourceArmServiceBusSubscription(),
+			"azurerm_servicebus_subscription_rule":                                           resourceArmServiceBusSubscriptionRule(),
+			"azurerm_servicebus_topic":                                                       resourceArmServiceBusTopic(),
+			"azurerm_servicebus_topic_authorization_rule":                                    resourceArmServiceBusTopicAuthorizationRule(),
+			"azurerm_snapshot":                                                               resourceArmSnapshot(),
+			"azurerm_scheduler_job_collection":                                               resourceArmSchedulerJobCollection(),
+			"azurerm_sql_database":                                                           resourceArmSqlDatabase(),
+			"azurerm_sql_elasticpool":                                                        resourceArmSqlElasticPool(),
+			"azurerm_sql_firewall_rule":                                                      resourceArmSqlFirewallRule(),
+			"azurerm_sql_active_directory_administrator":                                     resourceArmSqlAdministrator(),
+			"azurerm_sql_server":                                                             resourceArmSqlServer(),
+			"azurerm_sql_virtual_network_rule":                                               resourceArmSqlVirtualNetworkRule(),
+			"azurerm_storage_account":                                                        resourceArmStorageAccount(),
+			"azurerm_storage_blob":                                                           resourceArmStorageBlob(),
+			"azurerm_storage_container":                                                      resourceArmStorageContainer(),
+			"azurerm_storage_share":                                                          resourceArmStorageShare(),
+			"azurerm_storage_queue":                                                          resourceArmStorageQueue(),
+			"azurerm_storage_table":                                                          resourceArmStorageTable(),
+			"azurerm_subnet":                                                                 resourceArmSubnet(),
+			"azurerm_template_deployment":                                                    resourceArmTemplateDeployment(),
+			"azurerm_traffic_manager_endpoint":                                               resourceArmTrafficManagerEndpoint(),
+			"azurerm_traffic_manager_profile":                                                resourceArmTrafficManagerProfile(),
+			"azurerm_virtual_machine_extension":                                              resourceArmVirtualMachineExtensions(),
+			"azurerm_virtual_machine":                                                        resourceArmVirtualMachine(),
+			"azurerm_virtual_machine_scale_set":                                              resourceArmVirtualMachineScaleSet(),
+			"azurerm_virtual_network":                                                        resourceArmVirtualNetwork(),
+			"azurerm_virtual_network_gateway":                                                resourceArmVirtualNetworkGateway(),
+			"azurerm_virtual_network_gateway_connection":                                     resourceArmVirtualNetworkGatewayConnection(),
+			"azurerm_virtual_network_peering":                                                resourceArmVirtualNetworkPeering(),
 		},
 	}
 
@@ -24,6 +24,8 @@ func resourceArmApplicationGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmApplicationGatewayCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -129,6 +131,27 @@ func resourceArmApplicationGateway() *schema.Resource {
 							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 							ValidateFunc:     validation.StringInSlice([]string{"2.2.9", "3.0"}, true),
 						},
+
+						"disabled_rule_group": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"rule_group_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+
+									"rules": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Schema{
+											Type: schema.TypeInt,
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -526,6 +549,73 @@ func resourceArmApplicationGateway() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
+
+						"redirect_configuration_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"redirect_configuration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"redirect_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"redirect_type": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Permanent),
+								string(network.Temporary),
+								string(network.Found),
+								string(network.SeeOther),
+							}, true),
+						},
+
+						"target_listener_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"target_listener_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"target_url": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"include_path": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+
+						"include_query_string": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -547,7 +637,7 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 						"default_backend_address_pool_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 						},
 
 						"default_backend_address_pool_id": {
@@ -557,7 +647,7 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 						"default_backend_http_settings_name": {
 							Type:     schema.TypeString,
-							Required: true,
+							Optional: true,
 						},
 
 						"default_backend_http_settings_id": {
@@ -565,6 +655,16 @@ func resourceArmApplicationGateway() *schema.Resource {
 							Computed: true,
 						},
 
+						"default_redirect_configuration_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+
+						"default_redirect_configuration_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
 						"path_rule": {
 							Type:     schema.TypeList,
 							Required: true,
@@ -590,7 +690,7 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 									"backend_address_pool_name": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 
 									"backend_address_pool_id": {
@@ -600,13 +700,23 @@ func resourceArmApplicationGateway() *schema.Resource {
 
 									"backend_http_settings_name": {
 										Type:     schema.TypeString,
-										Required: true,
+										Optional: true,
 									},
 
 									"backend_http_settings_id": {
 										Type:     schema.TypeString,
 										Computed: true,
 									},
+
+									"redirect_configuration_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+
+									"redirect_configuration_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
 								},
 							},
 						},
@@ -678,6 +788,70 @@ func resourceArmApplicationGateway() *schema.Resource {
 	}
 }
 
+func resourceArmApplicationGatewayCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	for _, raw := range diff.Get("redirect_configuration").([]interface{}) {
+		config := raw.(map[string]interface{})
+		hasTargetListener := config["target_listener_name"].(string) != ""
+		hasTargetUrl := config["target_url"].(string) != ""
+		if hasTargetListener == hasTargetUrl {
+			return fmt.Errorf("Redirect Configuration %q: exactly one of `target_listener_name` or `target_url` must be set", config["name"].(string))
+		}
+	}
+
+	for _, raw := range diff.Get("request_routing_rule").([]interface{}) {
+		rule := raw.(map[string]interface{})
+		// Path Based Routing rules send requests to the backends defined in their URL Path Map instead
+		required := strings.EqualFold(rule["rule_type"].(string), string(network.Basic))
+		if err := validateApplicationGatewayRedirectTarget(rule, "redirect_configuration_name", "backend_address_pool_name", "backend_http_settings_name", required); err != nil {
+			return fmt.Errorf("Request Routing Rule %q: %+v", rule["name"].(string), err)
+		}
+	}
+
+	for _, raw := range diff.Get("url_path_map").([]interface{}) {
+		pathMap := raw.(map[string]interface{})
+		if err := validateApplicationGatewayRedirectTarget(pathMap, "default_redirect_configuration_name", "default_backend_address_pool_name", "default_backend_http_settings_name", true); err != nil {
+			return fmt.Errorf("URL Path Map %q: %+v", pathMap["name"].(string), err)
+		}
+
+		for _, ruleRaw := range pathMap["path_rule"].([]interface{}) {
+			rule := ruleRaw.(map[string]interface{})
+			if err := validateApplicationGatewayRedirectTarget(rule, "redirect_configuration_name", "backend_address_pool_name", "backend_http_settings_name", true); err != nil {
+				return fmt.Errorf("Path Rule %q of URL Path Map %q: %+v", rule["name"].(string), pathMap["name"].(string), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateApplicationGatewayRedirectTarget checks that requests are sent either to a Redirect Configuration or to a
+// Backend Address Pool using a set of Backend HTTP Settings - and when `required` is set, that one of these is set
+func validateApplicationGatewayRedirectTarget(config map[string]interface{}, redirectKey string, poolKey string, settingsKey string, required bool) error {
+	hasRedirect := config[redirectKey].(string) != ""
+	hasPool := config[poolKey].(string) != ""
+	hasSettings := config[settingsKey].(string) != ""
+
+	if hasRedirect {
+		for _, key := range []string{poolKey, settingsKey} {
+			if config[key].(string) != "" {
+				return fmt.Errorf("`%s` cannot be set when `%s` is set", key, redirectKey)
+			}
+		}
+
+		return nil
+	}
+
+	if hasPool != hasSettings {
+		return fmt.Errorf("`%s` and `%s` must be set together", poolKey, settingsKey)
+	}
+
+	if required && !hasPool {
+		return fmt.Errorf("either `%s` or both `%s` and `%s` must be set", redirectKey, poolKey, settingsKey)
+	}
+
+	return nil
+}
+
 func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta interface{}) error {
 	armClient := meta.(*ArmClient)
 	client := armClient.applicationGatewayClient
@@ -707,6 +881,7 @@ func resourceArmApplicationGatewayCreateUpdate(d *schema.ResourceData, meta inte
 	properties.Probes = expandApplicationGatewayProbes(d)
 	properties.RequestRoutingRules = expandApplicationGatewayRequestRoutingRules(d, gatewayID)
 	properties.URLPathMaps = expandApplicationGatewayURLPathMaps(d, gatewayID)
+	properties.RedirectConfigurations = expandApplicationGatewayRedirectConfigurations(d, gatewayID)
 	properties.AuthenticationCertificates = expandApplicationGatewayAuthenticationCertificates(d)
 	properties.SslCertificates = expandApplicationGatewaySslCertificates(d)
 
@@ -799,6 +974,12 @@ func resourceArmApplicationGatewayRead(d *schema.ResourceData, meta interface{})
 	}
 	d.Set("url_path_map", v4)
 
+	v5, err5 := flattenApplicationGatewayRedirectConfigurations(applicationGateway.ApplicationGatewayPropertiesFormat.RedirectConfigurations)
+	if err5 != nil {
+		return fmt.Errorf("error flattening RedirectConfigurations: %+v", err5)
+	}
+	d.Set("redirect_configuration", v5)
+
 	d.Set("authentication_certificate", schema.NewSet(hashApplicationGatewayAuthenticationCertificates, flattenApplicationGatewayAuthenticationCertificates(applicationGateway.ApplicationGatewayPropertiesFormat.AuthenticationCertificates)))
 	d.Set("ssl_certificate", schema.NewSet(hashApplicationGatewaySslCertificates, flattenApplicationGatewaySslCertificates(applicationGateway.ApplicationGatewayPropertiesFormat.SslCertificates)))
 
@@ -892,11 +1073,33 @@ func expandApplicationGatewayWafConfig(d *schema.ResourceData) *network.Applicat
 	rulesettype := waf["rule_set_type"].(string)
 	rulesetversion := waf["rule_set_version"].(string)
 
+	disabledRuleGroups := make([]network.ApplicationGatewayFirewallDisabledRuleGroup, 0)
+	for _, groupConfig := range waf["disabled_rule_group"].([]interface{}) {
+		group := groupConfig.(map[string]interface{})
+
+		ruleGroupName := group["rule_group_name"].(string)
+		disabledRuleGroup := network.ApplicationGatewayFirewallDisabledRuleGroup{
+			RuleGroupName: &ruleGroupName,
+		}
+
+		// when no rules are specified the entire rule group is disabled
+		if rulesConfig := group["rules"].([]interface{}); len(rulesConfig) > 0 {
+			rules := make([]int32, 0, len(rulesConfig))
+			for _, rule := range rulesConfig {
+				rules = append(rules, int32(rule.(int)))
+			}
+			disabledRuleGroup.Rules = &rules
+		}
+
+		disabledRuleGroups = append(disabledRuleGroups, disabledRuleGroup)
+	}
+
 	return &network.ApplicationGatewayWebApplicationFirewallConfiguration{
-		Enabled:        &enabled,
-		FirewallMode:   network.ApplicationGatewayFirewallMode(mode),
-		RuleSetType:    &rulesettype,
-		RuleSetVersion: &rulesetversion,
+		Enabled:            &enabled,
+		FirewallMode:       network.ApplicationGatewayFirewallMode(mode),
+		RuleSetType:        &rulesettype,
+		RuleSetVersion:     &rulesetversion,
+		DisabledRuleGroups: &disabledRuleGroups,
 	}
 }
 
@@ -1211,6 +1414,13 @@ func expandApplicationGatewayRequestRoutingRules(d *schema.ResourceData, gateway
 			}
 		}
 
+		if redirectConfigurationName := data["redirect_configuration_name"].(string); redirectConfigurationName != "" {
+			redirectConfigurationID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigurationName)
+			rule.ApplicationGatewayRequestRoutingRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+				ID: &redirectConfigurationID,
+			}
+		}
+
 		rules = append(rules, rule)
 	}
 
@@ -1225,10 +1435,6 @@ func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID strin
 		data := configRaw.(map[string]interface{})
 
 		name := data["name"].(string)
-		defaultBackendAddressPoolName := data["default_backend_address_pool_name"].(string)
-		defaultBackendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, defaultBackendAddressPoolName)
-		defaultBackendHTTPSettingsName := data["default_backend_http_settings_name"].(string)
-		defaultBackendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, defaultBackendHTTPSettingsName)
 
 		pathRules := []network.ApplicationGatewayPathRule{}
 		for _, ruleConfig := range data["path_rule"].([]interface{}) {
@@ -1262,28 +1468,88 @@ func expandApplicationGatewayURLPathMaps(d *schema.ResourceData, gatewayID strin
 				}
 			}
 
+			if redirectConfigurationName := ruleConfigMap["redirect_configuration_name"].(string); redirectConfigurationName != "" {
+				redirectConfigurationID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, redirectConfigurationName)
+				rule.ApplicationGatewayPathRulePropertiesFormat.RedirectConfiguration = &network.SubResource{
+					ID: &redirectConfigurationID,
+				}
+			}
+
 			pathRules = append(pathRules, rule)
 		}
 
 		pathMap := network.ApplicationGatewayURLPathMap{
 			Name: &name,
 			ApplicationGatewayURLPathMapPropertiesFormat: &network.ApplicationGatewayURLPathMapPropertiesFormat{
-				DefaultBackendAddressPool: &network.SubResource{
-					ID: &defaultBackendAddressPoolID,
-				},
-				DefaultBackendHTTPSettings: &network.SubResource{
-					ID: &defaultBackendHTTPSettingsID,
-				},
 				PathRules: &pathRules,
 			},
 		}
 
+		if defaultBackendAddressPoolName := data["default_backend_address_pool_name"].(string); defaultBackendAddressPoolName != "" {
+			defaultBackendAddressPoolID := fmt.Sprintf("%s/backendAddressPools/%s", gatewayID, defaultBackendAddressPoolName)
+			pathMap.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendAddressPool = &network.SubResource{
+				ID: &defaultBackendAddressPoolID,
+			}
+		}
+
+		if defaultBackendHTTPSettingsName := data["default_backend_http_settings_name"].(string); defaultBackendHTTPSettingsName != "" {
+			defaultBackendHTTPSettingsID := fmt.Sprintf("%s/backendHttpSettingsCollection/%s", gatewayID, defaultBackendHTTPSettingsName)
+			pathMap.ApplicationGatewayURLPathMapPropertiesFormat.DefaultBackendHTTPSettings = &network.SubResource{
+				ID: &defaultBackendHTTPSettingsID,
+			}
+		}
+
+		if defaultRedirectConfigurationName := data["default_redirect_configuration_name"].(string); defaultRedirectConfigurationName != "" {
+			defaultRedirectConfigurationID := fmt.Sprintf("%s/redirectConfigurations/%s", gatewayID, defaultRedirectConfigurationName)
+			pathMap.ApplicationGatewayURLPathMapPropertiesFormat.DefaultRedirectConfiguration = &network.SubResource{
+				ID: &defaultRedirectConfigurationID,
+			}
+		}
+
 		pathMaps = append(pathMaps, pathMap)
 	}
 
 	return &pathMaps
 }
 
+func expandApplicationGatewayRedirectConfigurations(d *schema.ResourceData, gatewayID string) *[]network.ApplicationGatewayRedirectConfiguration {
+	configs := d.Get("redirect_configuration").([]interface{})
+	redirectConfigurations := make([]network.ApplicationGatewayRedirectConfiguration, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		name := data["name"].(string)
+		redirectType := data["redirect_type"].(string)
+		includePath := data["include_path"].(bool)
+		includeQueryString := data["include_query_string"].(bool)
+
+		redirectConfiguration := network.ApplicationGatewayRedirectConfiguration{
+			Name: &name,
+			ApplicationGatewayRedirectConfigurationPropertiesFormat: &network.ApplicationGatewayRedirectConfigurationPropertiesFormat{
+				RedirectType:       network.ApplicationGatewayRedirectType(redirectType),
+				IncludePath:        &includePath,
+				IncludeQueryString: &includeQueryString,
+			},
+		}
+
+		if targetListenerName := data["target_listener_name"].(string); targetListenerName != "" {
+			targetListenerID := fmt.Sprintf("%s/httpListeners/%s", gatewayID, targetListenerName)
+			redirectConfiguration.ApplicationGatewayRedirectConfigurationPropertiesFormat.TargetListener = &network.SubResource{
+				ID: &targetListenerID,
+			}
+		}
+
+		if targetURL := data["target_url"].(string); targetURL != "" {
+			redirectConfiguration.ApplicationGatewayRedirectConfigurationPropertiesFormat.TargetURL = &targetURL
+		}
+
+		redirectConfigurations = append(redirectConfigurations, redirectConfiguration)
+	}
+
+	return &redirectConfigurations
+}
+
 func expandApplicationGatewayAuthenticationCertificates(d *schema.ResourceData) *[]network.ApplicationGatewayAuthenticationCertificate {
 	configs := d.Get("authentication_certificate").([]interface{})
 	authCerts := make([]network.ApplicationGatewayAuthenticationCertificate, 0, len(configs))
@@ -1356,6 +1622,28 @@ func flattenApplicationGatewayWafConfig(waf *network.ApplicationGatewayWebApplic
 	result["rule_set_type"] = waf.RuleSetType
 	result["rule_set_version"] = waf.RuleSetVersion
 
+	disabledRuleGroups := make([]interface{}, 0)
+	if groups := waf.DisabledRuleGroups; groups != nil {
+		for _, group := range *groups {
+			disabledRuleGroup := make(map[string]interface{})
+
+			if name := group.RuleGroupName; name != nil {
+				disabledRuleGroup["rule_group_name"] = *name
+			}
+
+			rules := make([]interface{}, 0)
+			if groupRules := group.Rules; groupRules != nil {
+				for _, rule := range *groupRules {
+					rules = append(rules, int(rule))
+				}
+			}
+			disabledRuleGroup["rules"] = rules
+
+			disabledRuleGroups = append(disabledRuleGroups, disabledRuleGroup)
+		}
+	}
+	result["disabled_rule_group"] = disabledRuleGroups
+
 	return []interface{}{result}
 }
 
@@ -1651,6 +1939,12 @@ func flattenApplicationGatewayRequestRoutingRules(input *[]network.ApplicationGa
 					listener["url_path_map_id"] = *pathMap.ID
 				}
 
+				if redirect := props.RedirectConfiguration; redirect != nil {
+					redirectConfigurationName := strings.Split(*redirect.ID, "/")[len(strings.Split(*redirect.ID, "/"))-1]
+					listener["redirect_configuration_name"] = redirectConfigurationName
+					listener["redirect_configuration_id"] = *redirect.ID
+				}
+
 				result = append(result, listener)
 			}
 		}
@@ -1682,6 +1976,12 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 					pathMap["default_backend_http_settings_id"] = *settings.ID
 				}
 
+				if redirect := props.DefaultRedirectConfiguration; redirect != nil {
+					redirectConfigurationName := strings.Split(*redirect.ID, "/")[len(strings.Split(*redirect.ID, "/"))-1]
+					pathMap["default_redirect_configuration_name"] = redirectConfigurationName
+					pathMap["default_redirect_configuration_id"] = *redirect.ID
+				}
+
 				pathRules := make([]interface{}, 0)
 				if rules := props.PathRules; rules != nil {
 					for _, pathRuleConfig := range *rules {
@@ -1703,6 +2003,12 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 								rule["backend_http_settings_id"] = *backend.ID
 							}
 
+							if redirect := ruleProps.RedirectConfiguration; redirect != nil {
+								redirectConfigurationName2 := strings.Split(*redirect.ID, "/")[len(strings.Split(*redirect.ID, "/"))-1]
+								rule["redirect_configuration_name"] = redirectConfigurationName2
+								rule["redirect_configuration_id"] = *redirect.ID
+							}
+
 							pathOutputs := make([]interface{}, 0)
 							if paths := ruleProps.Paths; paths != nil {
 								for _, rulePath := range *paths {
@@ -1725,6 +2031,45 @@ func flattenApplicationGatewayURLPathMaps(input *[]network.ApplicationGatewayURL
 	return result, nil
 }
 
+func flattenApplicationGatewayRedirectConfigurations(input *[]network.ApplicationGatewayRedirectConfiguration) ([]interface{}, error) {
+	result := make([]interface{}, 0)
+
+	if configs := input; configs != nil {
+		for _, config := range *configs {
+			redirectConfiguration := map[string]interface{}{
+				"id":   *config.ID,
+				"name": *config.Name,
+			}
+
+			if props := config.ApplicationGatewayRedirectConfigurationPropertiesFormat; props != nil {
+				redirectConfiguration["redirect_type"] = string(props.RedirectType)
+
+				if listener := props.TargetListener; listener != nil {
+					targetListenerName := strings.Split(*listener.ID, "/")[len(strings.Split(*listener.ID, "/"))-1]
+					redirectConfiguration["target_listener_name"] = targetListenerName
+					redirectConfiguration["target_listener_id"] = *listener.ID
+				}
+
+				if url := props.TargetURL; url != nil {
+					redirectConfiguration["target_url"] = *url
+				}
+
+				if includePath := props.IncludePath; includePath != nil {
+					redirectConfiguration["include_path"] = *includePath
+				}
+
+				if includeQueryString := props.IncludeQueryString; includeQueryString != nil {
+					redirectConfiguration["include_query_string"] = *includeQueryString
+				}
+			}
+
+			result = append(result, redirectConfiguration)
+		}
+	}
+
+	return result, nil
+}
+
 func flattenApplicationGatewayAuthenticationCertificates(input *[]network.ApplicationGatewayAuthenticationCertificate) []interface{} {
 	result := make([]interface{}, 0)
 
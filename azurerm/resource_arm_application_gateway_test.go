@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"log"
@@ -198,6 +200,94 @@ func TestAccAzureRMApplicationGateway_waf(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMApplicationGateway_redirectConfiguration(t *testing.T) {
+	resourceName := "azurerm_application_gateway.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGateway_redirectConfiguration(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.0.redirect_type", "Permanent"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.0.target_listener_name", "listener-https"),
+					resource.TestCheckResourceAttr(resourceName, "redirect_configuration.1.target_url", "https://www.terraform.io"),
+					resource.TestCheckResourceAttrSet(resourceName, "request_routing_rule.0.redirect_configuration_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "url_path_map.0.path_rule.0.redirect_configuration_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGateway_redirectConfigurationConflicts(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMApplicationGateway_redirectConfiguration(ri, testLocation())
+
+	targetConflict := strings.Replace(config, `target_url    = "https://www.terraform.io"`, `target_url    = "https://www.terraform.io"
+    target_listener_name = "listener-https"`, 1)
+	noTarget := strings.Replace(config, `
+    target_url    = "https://www.terraform.io"`, "", 1)
+	backendConflict := strings.Replace(config, `redirect_configuration_name = "redirect-http-to-https"`, `redirect_configuration_name = "redirect-http-to-https"
+    backend_address_pool_name   = "pool-1"`, 1)
+	noBackendOrRedirect := strings.Replace(config, `
+      redirect_configuration_name = "redirect-docs"`, "", 1)
+	poolWithoutSettings := strings.Replace(config, `redirect_configuration_name = "redirect-docs"`, `backend_address_pool_name   = "pool-1"`, 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      targetConflict,
+				ExpectError: regexp.MustCompile("exactly one of `target_listener_name` or `target_url` must be set"),
+			},
+			{
+				Config:      noTarget,
+				ExpectError: regexp.MustCompile("exactly one of `target_listener_name` or `target_url` must be set"),
+			},
+			{
+				Config:      backendConflict,
+				ExpectError: regexp.MustCompile("`backend_address_pool_name` cannot be set when `redirect_configuration_name` is set"),
+			},
+			{
+				Config:      noBackendOrRedirect,
+				ExpectError: regexp.MustCompile("either `redirect_configuration_name` or both `backend_address_pool_name` and `backend_http_settings_name` must be set"),
+			},
+			{
+				Config:      poolWithoutSettings,
+				ExpectError: regexp.MustCompile("`backend_address_pool_name` and `backend_http_settings_name` must be set together"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMApplicationGateway_wafDisabledRuleGroups(t *testing.T) {
+	resourceName := "azurerm_application_gateway.test"
+	ri := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMApplicationGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMApplicationGateway_wafDisabledRuleGroups(ri, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMApplicationGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "waf_configuration.#", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMApplicationGatewayExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -1310,3 +1400,337 @@ resource "azurerm_application_gateway" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMApplicationGateway_wafDisabledRuleGroups(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.254.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.0.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "WAF_Medium"
+    tier     = "WAF"
+    capacity = 1
+  }
+
+  disabled_ssl_protocols = [
+    "TLSv1_0",
+  ]
+
+  waf_configuration {
+    enabled = "true"
+    firewall_mode = "Detection"
+    rule_set_type = "OWASP"
+    rule_set_version = "3.0"
+
+    disabled_rule_group {
+      rule_group_name = "REQUEST-913-SCANNER-DETECTION"
+    }
+
+    disabled_rule_group {
+      rule_group_name = "REQUEST-942-APPLICATION-ATTACK-SQLI"
+      rules           = [942200, 942430]
+    }
+  }
+
+  gateway_ip_configuration {
+    # id = computed
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    # id = computed
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_ip_configuration {
+    # id = computed
+    name      = "ip-config-private"
+    subnet_id = "${azurerm_subnet.test.id}"
+
+    # private_ip_address = computed
+    private_ip_address_allocation = "Dynamic"
+  }
+
+  frontend_port {
+    # id = computed
+    name = "port-8080"
+    port = 8080
+  }
+
+  backend_address_pool {
+    # id = computed
+    name = "pool-1"
+
+    fqdn_list = [
+      "terraform.io",
+    ]
+  }
+
+  backend_http_settings {
+    # id = computed
+    name                  = "backend-http-1"
+    port                  = 8010
+    protocol              = "Https"
+    cookie_based_affinity = "Enabled"
+    request_timeout       = 30
+
+    # probe_id = computed
+    probe_name = "probe-1"
+  }
+
+  http_listener {
+    # id = computed
+    name = "listener-1"
+
+    # frontend_ip_configuration_id = computed
+    frontend_ip_configuration_name = "ip-config-public"
+
+    # frontend_ip_port_id = computed
+    frontend_port_name = "port-8080"
+    protocol           = "Http"
+  }
+
+  http_listener {
+    name                           = "listener-2"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-8080"
+    protocol                       = "Https"
+
+    # ssl_certificate_id = computed
+    ssl_certificate_name = "ssl-1"
+    host_name            = "terraform.io"
+    require_sni          = true
+  }
+
+  probe {
+    # id = computed
+    name                = "probe-1"
+    protocol            = "Https"
+    path                = "/test"
+    host                = "azure.com"
+    timeout             = 120
+    interval            = 300
+    unhealthy_threshold = 8
+  }
+
+  url_path_map {
+    # id = computed
+    name                               = "path-map-1"
+    default_backend_address_pool_name  = "pool-1"
+    default_backend_http_settings_name = "backend-http-1"
+
+    path_rule {
+      # id = computed
+      name                       = "path-rule-1"
+      backend_address_pool_name  = "pool-1"
+      backend_http_settings_name = "backend-http-1"
+
+      paths = [
+        "/test",
+      ]
+    }
+  }
+
+  request_routing_rule {
+    # id = computed
+    name      = "rule-basic-1"
+    rule_type = "Basic"
+
+    # http_listener_id = computed
+    http_listener_name = "listener-1"
+
+    # backend_address_pool_id = computed
+    backend_address_pool_name = "pool-1"
+
+    # backend_http_settings_id = computed
+    backend_http_settings_name = "backend-http-1"
+  }
+
+  request_routing_rule {
+    # id = computed
+    name              = "rule-path-1"
+    rule_type         = "PathBasedRouting"
+    url_path_map_name = "path-map-1"
+
+    # http_listener_id = computed
+    http_listener_name = "listener-2"
+  }
+
+  ssl_certificate {
+    # id = computed
+    name     = "ssl-1"
+    data     = "${file("testdata/application_gateway_test.pfx")}"
+    password = "terraform"
+  }
+
+  tags {
+    environment = "tf01"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
+
+func testAccAzureRMApplicationGateway_redirectConfiguration(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctest-vnet-%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.254.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "subnet-%d"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.254.0.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctest-pubip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "dynamic"
+}
+
+resource "azurerm_application_gateway" "test" {
+  name                = "acctestgw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  sku {
+    name     = "Standard_Small"
+    tier     = "Standard"
+    capacity = 1
+  }
+
+  gateway_ip_configuration {
+    name      = "gw-ip-config1"
+    subnet_id = "${azurerm_subnet.test.id}"
+  }
+
+  frontend_ip_configuration {
+    name                 = "ip-config-public"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+
+  frontend_port {
+    name = "port-80"
+    port = 80
+  }
+
+  frontend_port {
+    name = "port-443"
+    port = 443
+  }
+
+  backend_address_pool {
+    name = "pool-1"
+  }
+
+  backend_http_settings {
+    name                  = "backend-http-1"
+    port                  = 80
+    protocol              = "Http"
+    cookie_based_affinity = "Disabled"
+    request_timeout       = 30
+  }
+
+  http_listener {
+    name                           = "listener-http"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-80"
+    protocol                       = "Http"
+  }
+
+  http_listener {
+    name                           = "listener-https"
+    frontend_ip_configuration_name = "ip-config-public"
+    frontend_port_name             = "port-443"
+    protocol                       = "Https"
+    ssl_certificate_name           = "ssl-1"
+  }
+
+  ssl_certificate {
+    name     = "ssl-1"
+    data     = "${file("testdata/application_gateway_test.pfx")}"
+    password = "terraform"
+  }
+
+  redirect_configuration {
+    name                 = "redirect-http-to-https"
+    redirect_type        = "Permanent"
+    target_listener_name = "listener-https"
+    include_path         = true
+    include_query_string = true
+  }
+
+  redirect_configuration {
+    name          = "redirect-docs"
+    redirect_type = "Temporary"
+    target_url    = "https://www.terraform.io"
+  }
+
+  url_path_map {
+    name                               = "path-map-1"
+    default_backend_address_pool_name  = "pool-1"
+    default_backend_http_settings_name = "backend-http-1"
+
+    path_rule {
+      name                        = "path-rule-1"
+      paths                       = ["/docs/*"]
+      redirect_configuration_name = "redirect-docs"
+    }
+  }
+
+  request_routing_rule {
+    name                        = "rule-http"
+    rule_type                   = "Basic"
+    http_listener_name          = "listener-http"
+    redirect_configuration_name = "redirect-http-to-https"
+  }
+
+  request_routing_rule {
+    name               = "rule-https"
+    rule_type          = "PathBasedRouting"
+    http_listener_name = "listener-https"
+    url_path_map_name  = "path-map-1"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt)
+}
@@ -0,0 +1,582 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/zonefile"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmDnsZoneRecordsCreateUpdate,
+		Read:   resourceArmDnsZoneRecordsRead,
+		Update: resourceArmDnsZoneRecordsCreateUpdate,
+		Delete: resourceArmDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: resourceArmDnsZoneRecordsCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"zone_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"zone_file": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: dnsZoneFileDiffSuppressFunc,
+			},
+
+			"record_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"ttl": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+
+						"records": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmDnsZoneRecordsCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	zonesClient := meta.(*ArmClient).zonesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	zoneName := d.Get("zone_name").(string)
+
+	zone, err := zonesClient.Get(ctx, resourceGroup, zoneName)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			return fmt.Errorf("Error: DNS Zone %q (Resource Group %q) was not found", zoneName, resourceGroup)
+		}
+		return fmt.Errorf("Error retrieving DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+	}
+	if zone.ID == nil {
+		return fmt.Errorf("Cannot read DNS Zone %q (Resource Group %q) ID", zoneName, resourceGroup)
+	}
+
+	desired, err := zonefile.Parse(d.Get("zone_file").(string), zoneName)
+	if err != nil {
+		return fmt.Errorf("Error parsing `zone_file`: %+v", err)
+	}
+
+	existing, err := listDnsZoneRecordSets(meta, resourceGroup, zoneName)
+	if err != nil {
+		return err
+	}
+
+	existingSets := make(map[string]zonefile.RecordSet)
+	for _, set := range existing {
+		existingSets[dnsZoneRecordSetKey(set)] = set
+	}
+
+	desiredSets := make(map[string]zonefile.RecordSet)
+	for _, set := range desired {
+		desiredSets[dnsZoneRecordSetKey(set)] = set
+	}
+
+	// stale Record Sets are removed first, since a CNAME can't share a name with other records - for example
+	// when `www` changes from a CNAME to an A record, the CNAME has to be deleted before the A record is created
+	for key, set := range existingSets {
+		if _, ok := desiredSets[key]; ok {
+			continue
+		}
+
+		log.Printf("[DEBUG] Deleting %s Record Set %q from DNS Zone %q (Resource Group %q) since it's not defined in the zone file", set.Type, set.Name, zoneName, resourceGroup)
+		resp, err := client.Delete(ctx, resourceGroup, zoneName, set.Name, dns.RecordType(set.Type), "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error deleting %s Record Set %q from DNS Zone %q (Resource Group %q): %+v", set.Type, set.Name, zoneName, resourceGroup, err)
+			}
+		}
+	}
+
+	for _, set := range desired {
+		if current, ok := existingSets[dnsZoneRecordSetKey(set)]; ok && zonefile.Equal(set, current) {
+			continue
+		}
+
+		parameters, err := expandAzureRmDnsZoneRecordSet(set)
+		if err != nil {
+			return err
+		}
+
+		log.Printf("[DEBUG] Creating/Updating %s Record Set %q in DNS Zone %q (Resource Group %q)", set.Type, set.Name, zoneName, resourceGroup)
+		eTag := ""
+		ifNoneMatch := "" // set to empty to allow updates to records after creation
+		_, err = client.CreateOrUpdate(ctx, resourceGroup, zoneName, set.Name, dns.RecordType(set.Type), parameters, eTag, ifNoneMatch)
+		if err != nil {
+			return fmt.Errorf("Error Creating/Updating %s Record Set %q in DNS Zone %q (Resource Group %q): %+v", set.Type, set.Name, zoneName, resourceGroup, err)
+		}
+	}
+
+	d.SetId(*zone.ID)
+
+	return resourceArmDnsZoneRecordsRead(d, meta)
+}
+
+func resourceArmDnsZoneRecordsCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	// GetOk returns false for values which aren't known until apply, in which case the zone file is parsed then
+	zoneName, zoneNameKnown := diff.GetOk("zone_name")
+	zoneFile, zoneFileKnown := diff.GetOk("zone_file")
+	if !zoneNameKnown || !zoneFileKnown {
+		return nil
+	}
+
+	if _, err := zonefile.Parse(zoneFile.(string), zoneName.(string)); err != nil {
+		return fmt.Errorf("Error parsing `zone_file`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	zonesClient := meta.(*ArmClient).zonesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	zoneName := id.Path["dnszones"]
+
+	zone, err := zonesClient.Get(ctx, resourceGroup, zoneName)
+	if err != nil {
+		if utils.ResponseWasNotFound(zone.Response) {
+			log.Printf("[DEBUG] DNS Zone %q was not found in Resource Group %q - removing from state", zoneName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+	}
+
+	actual, err := listDnsZoneRecordSets(meta, resourceGroup, zoneName)
+	if err != nil {
+		return err
+	}
+
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("zone_name", zoneName)
+
+	// only replace the configured zone file when the records have drifted, so that formatting and comments are kept
+	desired, err := zonefile.Parse(d.Get("zone_file").(string), zoneName)
+	if err != nil {
+		desired = make([]zonefile.RecordSet, 0)
+	}
+	drift := diffDnsZoneRecordSets(desired, actual)
+	for _, message := range drift {
+		log.Printf("[WARN] DNS Zone %q (Resource Group %q): %s", zoneName, resourceGroup, message)
+	}
+	if len(drift) > 0 {
+		d.Set("zone_file", zonefile.Render(zoneName, actual))
+	}
+
+	if err := d.Set("record_set", flattenAzureRmDnsZoneRecordSets(actual)); err != nil {
+		return fmt.Errorf("Error flattening `record_set`: %+v", err)
+	}
+
+	return nil
+}
+
+func resourceArmDnsZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	zoneName := id.Path["dnszones"]
+
+	sets, err := zonefile.Parse(d.Get("zone_file").(string), zoneName)
+	if err != nil {
+		return fmt.Errorf("Error parsing `zone_file`: %+v", err)
+	}
+
+	for _, set := range sets {
+		resp, err := client.Delete(ctx, resourceGroup, zoneName, set.Name, dns.RecordType(set.Type), "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("Error deleting %s Record Set %q from DNS Zone %q (Resource Group %q): %+v", set.Type, set.Name, zoneName, resourceGroup, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// listDnsZoneRecordSets returns the Record Sets in the DNS Zone which are managed by this resource,
+// which is everything other than the SOA record and the NS records at the apex of the zone.
+func listDnsZoneRecordSets(meta interface{}, resourceGroup string, zoneName string) ([]zonefile.RecordSet, error) {
+	client := meta.(*ArmClient).dnsClient
+	ctx := meta.(*ArmClient).StopContext
+
+	results := make([]zonefile.RecordSet, 0)
+
+	iterator, err := client.ListByDNSZoneComplete(ctx, resourceGroup, zoneName, nil, "")
+	if err != nil {
+		return nil, fmt.Errorf("Error listing Record Sets in DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+	}
+	for iterator.NotDone() {
+		if set := flattenAzureRmDnsZoneRecordSet(iterator.Value()); set != nil {
+			results = append(results, *set)
+		}
+
+		if err := iterator.Next(); err != nil {
+			return nil, fmt.Errorf("Error listing Record Sets in DNS Zone %q (Resource Group %q): %+v", zoneName, resourceGroup, err)
+		}
+	}
+
+	zonefile.Sort(results)
+	return results, nil
+}
+
+func expandAzureRmDnsZoneRecordSet(set zonefile.RecordSet) (dns.RecordSet, error) {
+	ttl := set.TTL
+	props := dns.RecordSetProperties{
+		TTL: &ttl,
+	}
+
+	switch set.Type {
+	case "A":
+		records := make([]dns.ARecord, 0)
+		for _, v := range set.Records {
+			records = append(records, dns.ARecord{
+				Ipv4Address: utils.String(v),
+			})
+		}
+		props.ARecords = &records
+
+	case "AAAA":
+		records := make([]dns.AaaaRecord, 0)
+		for _, v := range set.Records {
+			records = append(records, dns.AaaaRecord{
+				Ipv6Address: utils.String(v),
+			})
+		}
+		props.AaaaRecords = &records
+
+	case "CNAME":
+		props.CnameRecord = &dns.CnameRecord{
+			Cname: utils.String(set.Records[0]),
+		}
+
+	case "MX":
+		records := make([]dns.MxRecord, 0)
+		for _, v := range set.Records {
+			fields := strings.Fields(v)
+			if len(fields) != 2 {
+				return dns.RecordSet{}, fmt.Errorf("Expected MX Record %q to contain a preference and an exchange but got %q", set.Name, v)
+			}
+			preference, err := strconv.ParseInt(fields[0], 10, 32)
+			if err != nil {
+				return dns.RecordSet{}, fmt.Errorf("Error parsing the preference for MX Record %q: %+v", set.Name, err)
+			}
+			records = append(records, dns.MxRecord{
+				Preference: utils.Int32(int32(preference)),
+				Exchange:   utils.String(fields[1]),
+			})
+		}
+		props.MxRecords = &records
+
+	case "NS":
+		records := make([]dns.NsRecord, 0)
+		for _, v := range set.Records {
+			records = append(records, dns.NsRecord{
+				Nsdname: utils.String(v),
+			})
+		}
+		props.NsRecords = &records
+
+	case "PTR":
+		records := make([]dns.PtrRecord, 0)
+		for _, v := range set.Records {
+			records = append(records, dns.PtrRecord{
+				Ptrdname: utils.String(v),
+			})
+		}
+		props.PtrRecords = &records
+
+	case "SRV":
+		records := make([]dns.SrvRecord, 0)
+		for _, v := range set.Records {
+			fields := strings.Fields(v)
+			if len(fields) != 4 {
+				return dns.RecordSet{}, fmt.Errorf("Expected SRV Record %q to contain a priority, weight, port and target but got %q", set.Name, v)
+			}
+			values := make([]int32, 0, 3)
+			for _, field := range fields[0:3] {
+				i, err := strconv.ParseInt(field, 10, 32)
+				if err != nil {
+					return dns.RecordSet{}, fmt.Errorf("Error parsing SRV Record %q: %+v", set.Name, err)
+				}
+				values = append(values, int32(i))
+			}
+			records = append(records, dns.SrvRecord{
+				Priority: utils.Int32(values[0]),
+				Weight:   utils.Int32(values[1]),
+				Port:     utils.Int32(values[2]),
+				Target:   utils.String(fields[3]),
+			})
+		}
+		props.SrvRecords = &records
+
+	case "TXT":
+		records := make([]dns.TxtRecord, 0)
+		for _, v := range set.Records {
+			// each string within a TXT record is limited to 255 characters, so longer values are split
+			value := make([]string, 0)
+			for len(v) > 255 {
+				value = append(value, v[:255])
+				v = v[255:]
+			}
+			value = append(value, v)
+
+			records = append(records, dns.TxtRecord{
+				Value: &value,
+			})
+		}
+		props.TxtRecords = &records
+
+	default:
+		return dns.RecordSet{}, fmt.Errorf("Record Sets of type %q are not supported", set.Type)
+	}
+
+	return dns.RecordSet{
+		Name:                utils.String(set.Name),
+		RecordSetProperties: &props,
+	}, nil
+}
+
+func flattenAzureRmDnsZoneRecordSet(input dns.RecordSet) *zonefile.RecordSet {
+	if input.Name == nil || input.Type == nil {
+		return nil
+	}
+
+	// the type is returned in the format `Microsoft.Network/dnszones/A`
+	typeSegments := strings.Split(*input.Type, "/")
+	recordType := strings.ToUpper(typeSegments[len(typeSegments)-1])
+	name := strings.ToLower(*input.Name)
+
+	if recordType == "SOA" || (recordType == "NS" && name == "@") {
+		return nil
+	}
+
+	set := zonefile.RecordSet{
+		Name:    name,
+		Type:    recordType,
+		Records: make([]string, 0),
+	}
+
+	props := input.RecordSetProperties
+	if props == nil {
+		return &set
+	}
+
+	if props.TTL != nil {
+		set.TTL = *props.TTL
+	}
+
+	target := func(input *string) string {
+		if input == nil {
+			return ""
+		}
+		return strings.TrimSuffix(strings.ToLower(*input), ".")
+	}
+
+	address := func(input *string) string {
+		if input == nil {
+			return ""
+		}
+		if ip := net.ParseIP(*input); ip != nil {
+			return ip.String()
+		}
+		return *input
+	}
+
+	switch recordType {
+	case "A":
+		if records := props.ARecords; records != nil {
+			for _, r := range *records {
+				set.Records = append(set.Records, address(r.Ipv4Address))
+			}
+		}
+
+	case "AAAA":
+		if records := props.AaaaRecords; records != nil {
+			for _, r := range *records {
+				set.Records = append(set.Records, address(r.Ipv6Address))
+			}
+		}
+
+	case "CNAME":
+		if record := props.CnameRecord; record != nil {
+			set.Records = append(set.Records, target(record.Cname))
+		}
+
+	case "MX":
+		if records := props.MxRecords; records != nil {
+			for _, r := range *records {
+				preference := int32(0)
+				if r.Preference != nil {
+					preference = *r.Preference
+				}
+				set.Records = append(set.Records, fmt.Sprintf("%d %s", preference, target(r.Exchange)))
+			}
+		}
+
+	case "NS":
+		if records := props.NsRecords; records != nil {
+			for _, r := range *records {
+				set.Records = append(set.Records, target(r.Nsdname))
+			}
+		}
+
+	case "PTR":
+		if records := props.PtrRecords; records != nil {
+			for _, r := range *records {
+				set.Records = append(set.Records, target(r.Ptrdname))
+			}
+		}
+
+	case "SRV":
+		if records := props.SrvRecords; records != nil {
+			for _, r := range *records {
+				values := make([]int32, 0, 3)
+				for _, v := range []*int32{r.Priority, r.Weight, r.Port} {
+					i := int32(0)
+					if v != nil {
+						i = *v
+					}
+					values = append(values, i)
+				}
+				set.Records = append(set.Records, fmt.Sprintf("%d %d %d %s", values[0], values[1], values[2], target(r.Target)))
+			}
+		}
+
+	case "TXT":
+		if records := props.TxtRecords; records != nil {
+			for _, r := range *records {
+				if r.Value != nil {
+					set.Records = append(set.Records, strings.Join(*r.Value, ""))
+				}
+			}
+		}
+	}
+
+	sort.Strings(set.Records)
+	return &set
+}
+
+func flattenAzureRmDnsZoneRecordSets(input []zonefile.RecordSet) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, set := range input {
+		records := make([]interface{}, 0)
+		for _, record := range set.Records {
+			records = append(records, record)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":    set.Name,
+			"type":    set.Type,
+			"ttl":     int(set.TTL),
+			"records": records,
+		})
+	}
+
+	return results
+}
+
+// diffDnsZoneRecordSets returns a message describing each Record Set which differs between the zone file and Azure
+func diffDnsZoneRecordSets(desired []zonefile.RecordSet, actual []zonefile.RecordSet) []string {
+	messages := make([]string, 0)
+
+	actualSets := make(map[string]zonefile.RecordSet)
+	for _, set := range actual {
+		actualSets[dnsZoneRecordSetKey(set)] = set
+	}
+
+	desiredSets := make(map[string]zonefile.RecordSet)
+	for _, set := range desired {
+		key := dnsZoneRecordSetKey(set)
+		desiredSets[key] = set
+
+		current, ok := actualSets[key]
+		if !ok {
+			messages = append(messages, fmt.Sprintf("%s Record Set %q has been removed", set.Type, set.Name))
+			continue
+		}
+
+		if !zonefile.Equal(set, current) {
+			messages = append(messages, fmt.Sprintf("%s Record Set %q has been modified (expected TTL %d with %q but got TTL %d with %q)", set.Type, set.Name, set.TTL, set.Records, current.TTL, current.Records))
+		}
+	}
+
+	for _, set := range actual {
+		if _, ok := desiredSets[dnsZoneRecordSetKey(set)]; !ok {
+			messages = append(messages, fmt.Sprintf("%s Record Set %q is not defined in the zone file", set.Type, set.Name))
+		}
+	}
+
+	return messages
+}
+
+func dnsZoneRecordSetKey(set zonefile.RecordSet) string {
+	return fmt.Sprintf("%s/%s", set.Name, set.Type)
+}
+
+func dnsZoneFileDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	zoneName := d.Get("zone_name").(string)
+
+	oldSets, err := zonefile.Parse(old, zoneName)
+	if err != nil {
+		return false
+	}
+
+	newSets, err := zonefile.Parse(new, zoneName)
+	if err != nil {
+		return false
+	}
+
+	return len(diffDnsZoneRecordSets(oldSets, newSets)) == 0
+}
@@ -0,0 +1,171 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/dns/mgmt/2016-04-01/dns"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMDnsZoneRecords_basic(t *testing.T) {
+	resourceName := "azurerm_dns_zone_records.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMDnsZoneRecordsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMDnsZoneRecords_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneRecordSetExists(resourceName, "@", dns.A),
+					testCheckAzureRMDnsZoneRecordSetExists(resourceName, "www", dns.CNAME),
+					testCheckAzureRMDnsZoneRecordSetExists(resourceName, "_sip._tcp", dns.SRV),
+					resource.TestCheckResourceAttr(resourceName, "record_set.#", "5"),
+				),
+			},
+			{
+				Config: testAccAzureRMDnsZoneRecords_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMDnsZoneRecordSetExists(resourceName, "@", dns.A),
+					testCheckAzureRMDnsZoneRecordSetExists(resourceName, "www", dns.A),
+					testCheckAzureRMDnsZoneRecordSetExists(resourceName, "api", dns.AAAA),
+					resource.TestCheckResourceAttr(resourceName, "record_set.#", "4"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"zone_file"},
+			},
+		},
+	})
+}
+
+func testCheckAzureRMDnsZoneRecordSetExists(name string, recordSetName string, recordType dns.RecordType) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for DNS Zone Records: %q", zoneName)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).dnsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, zoneName, recordSetName, recordType)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: %s Record Set %q (DNS Zone %q / resource group: %q) does not exist", recordType, recordSetName, zoneName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on dnsClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMDnsZoneRecordsDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).zonesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_dns_zone_records" {
+			continue
+		}
+
+		zoneName := rs.Primary.Attributes["zone_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, zoneName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		sets, err := listDnsZoneRecordSets(testAccProvider.Meta(), resourceGroup, zoneName)
+		if err != nil {
+			return err
+		}
+
+		if len(sets) > 0 {
+			return fmt.Errorf("DNS Zone %q still contains %d Record Sets", zoneName, len(sets))
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMDnsZoneRecords_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+
+  zone_file = <<ZONE
+$TTL 1h
+@          IN  NS     ns1.example.net.
+@      300 IN  A      192.0.2.10
+           IN  A      192.0.2.11
+           IN  MX     10 mail
+           IN  TXT    "v=spf1 mx ~all"
+www        IN  CNAME  @
+_sip._tcp  IN  SRV    10 60 5060 www
+ZONE
+}
+`, rInt, location, rInt)
+}
+
+func testAccAzureRMDnsZoneRecords_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_dns_zone" "test" {
+  name                = "acctestzone%d.com"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_dns_zone_records" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  zone_name           = "${azurerm_dns_zone.test.name}"
+
+  zone_file = <<ZONE
+; www has changed from a CNAME to an A record, the SRV record has been removed and api has been added
+$TTL 1h
+@      300 IN  A      192.0.2.10
+           IN  MX     10 mail
+www        IN  A      192.0.2.20
+api        IN  AAAA   2001:db8::1
+ZONE
+}
+`, rInt, location, rInt)
+}
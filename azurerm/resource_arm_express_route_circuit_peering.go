@@ -84,6 +84,11 @@ func resourceArmExpressRouteCircuitPeering() *schema.Resource {
 				},
 			},
 
+			"route_filter_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
 			"azure_asn": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -141,6 +146,24 @@ func resourceArmExpressRouteCircuitPeeringCreateUpdate(d *schema.ResourceData, m
 		parameters.ExpressRouteCircuitPeeringPropertiesFormat.MicrosoftPeeringConfig = peeringConfig
 	}
 
+	if routeFilterId := d.Get("route_filter_id").(string); routeFilterId != "" {
+		if !strings.EqualFold(peeringType, string(network.MicrosoftPeering)) {
+			return fmt.Errorf("`route_filter_id` can only be specified when `peering_type` is set to `MicrosoftPeering`")
+		}
+
+		routeFilterName, err := parseRouteFilterName(routeFilterId)
+		if err != nil {
+			return err
+		}
+
+		azureRMLockByName(routeFilterName, routeFilterResourceName)
+		defer azureRMUnlockByName(routeFilterName, routeFilterResourceName)
+
+		parameters.ExpressRouteCircuitPeeringPropertiesFormat.RouteFilter = &network.RouteFilter{
+			ID: utils.String(routeFilterId),
+		}
+	}
+
 	azureRMLockByName(circuitName, expressRouteCircuitResourceName)
 	defer azureRMUnlockByName(circuitName, expressRouteCircuitResourceName)
 
@@ -202,6 +225,12 @@ func resourceArmExpressRouteCircuitPeeringRead(d *schema.ResourceData, meta inte
 		if err := d.Set("microsoft_peering_config", config); err != nil {
 			return fmt.Errorf("Error flattening `microsoft_peering_config`: %+v", err)
 		}
+
+		routeFilterId := ""
+		if filter := props.RouteFilter; filter != nil && filter.ID != nil {
+			routeFilterId = *filter.ID
+		}
+		d.Set("route_filter_id", routeFilterId)
 	}
 
 	return nil
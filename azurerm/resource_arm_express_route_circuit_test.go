@@ -26,8 +26,9 @@ func TestAccAzureRMExpressRouteCircuit(t *testing.T) {
 			"importPrivatePeering": testAccAzureRMExpressRouteCircuitPeering_importAzurePrivatePeering,
 		},
 		"MicrosoftPeering": {
-			"microsoftPeering":       testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
-			"importMicrosoftPeering": testAccAzureRMExpressRouteCircuitPeering_importMicrosoftPeering,
+			"microsoftPeering":                testAccAzureRMExpressRouteCircuitPeering_microsoftPeering,
+			"microsoftPeeringWithRouteFilter": testAccAzureRMExpressRouteCircuitPeering_microsoftPeeringWithRouteFilter,
+			"importMicrosoftPeering":          testAccAzureRMExpressRouteCircuitPeering_importMicrosoftPeering,
 		},
 		"authorization": {
 			"basic":    testAccAzureRMExpressRouteCircuitAuthorization_basic,
@@ -4,12 +4,14 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
 	"github.com/hashicorp/errwrap"
 	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
@@ -23,6 +25,8 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 			State: loadBalancerSubResourceStateImporter,
 		},
 
+		CustomizeDiff: resourceArmLoadBalancerRuleCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:         schema.TypeString,
@@ -62,16 +66,23 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 				Required:         true,
 				StateFunc:        ignoreCaseStateFunc,
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.TransportProtocolAll),
+					string(network.TransportProtocolTCP),
+					string(network.TransportProtocolUDP),
+				}, true),
 			},
 
 			"frontend_port": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65534),
 			},
 
 			"backend_port": {
-				Type:     schema.TypeInt,
-				Required: true,
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(0, 65535),
 			},
 
 			"probe_id": {
@@ -86,6 +97,12 @@ func resourceArmLoadBalancerRule() *schema.Resource {
 				Default:  false,
 			},
 
+			"disable_outbound_snat": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"idle_timeout_in_minutes": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -220,6 +237,10 @@ func resourceArmLoadBalancerRuleRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("enable_floating_ip", config.LoadBalancingRulePropertiesFormat.EnableFloatingIP)
 	}
 
+	if config.LoadBalancingRulePropertiesFormat.DisableOutboundSnat != nil {
+		d.Set("disable_outbound_snat", config.LoadBalancingRulePropertiesFormat.DisableOutboundSnat)
+	}
+
 	if config.LoadBalancingRulePropertiesFormat.IdleTimeoutInMinutes != nil {
 		d.Set("idle_timeout_in_minutes", config.LoadBalancingRulePropertiesFormat.IdleTimeoutInMinutes)
 	}
@@ -302,12 +323,21 @@ func resourceArmLoadBalancerRuleDelete(d *schema.ResourceData, meta interface{})
 }
 
 func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalancer) (*network.LoadBalancingRule, error) {
+	protocol := d.Get("protocol").(string)
+	frontendPort := d.Get("frontend_port").(int)
+	backendPort := d.Get("backend_port").(int)
+
+	// the CustomizeDiff can't check ports which are `0` (see below), so these are also validated here
+	if err := validateArmLoadBalancerRuleHAPorts(protocol, frontendPort, backendPort); err != nil {
+		return nil, err
+	}
 
 	properties := network.LoadBalancingRulePropertiesFormat{
-		Protocol:         network.TransportProtocol(d.Get("protocol").(string)),
-		FrontendPort:     utils.Int32(int32(d.Get("frontend_port").(int))),
-		BackendPort:      utils.Int32(int32(d.Get("backend_port").(int))),
-		EnableFloatingIP: utils.Bool(d.Get("enable_floating_ip").(bool)),
+		Protocol:            network.TransportProtocol(protocol),
+		FrontendPort:        utils.Int32(int32(frontendPort)),
+		BackendPort:         utils.Int32(int32(backendPort)),
+		EnableFloatingIP:    utils.Bool(d.Get("enable_floating_ip").(bool)),
+		DisableOutboundSnat: utils.Bool(d.Get("disable_outbound_snat").(bool)),
 	}
 
 	if v, ok := d.GetOk("idle_timeout_in_minutes"); ok {
@@ -355,6 +385,42 @@ func expandAzureRmLoadBalancerRule(d *schema.ResourceData, lb *network.LoadBalan
 	return &lbRule, nil
 }
 
+func resourceArmLoadBalancerRuleCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	protocol, ok := diff.GetOk("protocol")
+	if !ok {
+		// the protocol isn't known until apply
+		return nil
+	}
+
+	// GetOk returns false both for a port of `0` and for one which isn't known until apply - so we can only
+	// check for non-zero ports here; a `0` port used with another protocol is caught when the rule's created
+	_, hasFrontendPort := diff.GetOk("frontend_port")
+	_, hasBackendPort := diff.GetOk("backend_port")
+	if strings.EqualFold(protocol.(string), string(network.TransportProtocolAll)) && (hasFrontendPort || hasBackendPort) {
+		return validateArmLoadBalancerRuleHAPorts(protocol.(string), diff.Get("frontend_port").(int), diff.Get("backend_port").(int))
+	}
+
+	return nil
+}
+
+// HA Ports rules load balance all ports, which is configured by using the `All` protocol
+// with both the Frontend and Backend Ports set to `0` - which isn't valid for any other protocol
+func validateArmLoadBalancerRuleHAPorts(protocol string, frontendPort int, backendPort int) error {
+	if strings.EqualFold(protocol, string(network.TransportProtocolAll)) {
+		if frontendPort != 0 || backendPort != 0 {
+			return fmt.Errorf("`frontend_port` and `backend_port` must both be `0` when `protocol` is `All` (HA Ports)")
+		}
+
+		return nil
+	}
+
+	if frontendPort == 0 || backendPort == 0 {
+		return fmt.Errorf("`frontend_port` and `backend_port` can only be `0` when `protocol` is `All` (HA Ports)")
+	}
+
+	return nil
+}
+
 func validateArmLoadBalancerRuleName(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	if !regexp.MustCompile(`^[a-zA-Z_0-9.-]+$`).MatchString(value) {
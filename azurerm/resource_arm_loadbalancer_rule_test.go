@@ -3,6 +3,8 @@ package azurerm
 import (
 	"fmt"
 	"os"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
@@ -67,6 +69,68 @@ func TestResourceAzureRMLoadBalancerRuleNameLabel_validation(t *testing.T) {
 	}
 }
 
+func TestResourceAzureRMLoadBalancerRuleHAPorts_validation(t *testing.T) {
+	cases := []struct {
+		Protocol     string
+		FrontendPort int
+		BackendPort  int
+		ExpectError  bool
+	}{
+		{
+			Protocol:     "All",
+			FrontendPort: 0,
+			BackendPort:  0,
+			ExpectError:  false,
+		},
+		{
+			Protocol:     "all",
+			FrontendPort: 0,
+			BackendPort:  0,
+			ExpectError:  false,
+		},
+		{
+			Protocol:     "All",
+			FrontendPort: 80,
+			BackendPort:  0,
+			ExpectError:  true,
+		},
+		{
+			Protocol:     "All",
+			FrontendPort: 0,
+			BackendPort:  80,
+			ExpectError:  true,
+		},
+		{
+			Protocol:     "Tcp",
+			FrontendPort: 80,
+			BackendPort:  8080,
+			ExpectError:  false,
+		},
+		{
+			Protocol:     "Tcp",
+			FrontendPort: 0,
+			BackendPort:  0,
+			ExpectError:  true,
+		},
+		{
+			Protocol:     "Udp",
+			FrontendPort: 53,
+			BackendPort:  0,
+			ExpectError:  true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateArmLoadBalancerRuleHAPorts(tc.Protocol, tc.FrontendPort, tc.BackendPort)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for Protocol %q / Frontend Port %d / Backend Port %d but didn't get one", tc.Protocol, tc.FrontendPort, tc.BackendPort)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for Protocol %q / Frontend Port %d / Backend Port %d but got: %+v", tc.Protocol, tc.FrontendPort, tc.BackendPort, err)
+		}
+	}
+}
+
 func TestAccAzureRMLoadBalancerRule_basic(t *testing.T) {
 	var lb network.LoadBalancer
 	ri := acctest.RandInt()
@@ -254,6 +318,70 @@ func TestAccAzureRMLoadBalancerRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMLoadBalancerRule_haPorts(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerRule_haPorts(ri, lbRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerRuleExists(lbRuleName, &lb),
+					resource.TestCheckResourceAttr("azurerm_lb_rule.test", "protocol", "All"),
+					resource.TestCheckResourceAttr("azurerm_lb_rule.test", "frontend_port", "0"),
+					resource.TestCheckResourceAttr("azurerm_lb_rule.test", "backend_port", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLoadBalancerRule_haPortsInvalidPort(t *testing.T) {
+	ri := acctest.RandInt()
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+	config := strings.Replace(testAccAzureRMLoadBalancerRule_haPorts(ri, lbRuleName, testLocation()), "frontend_port                  = 0", "frontend_port                  = 80", 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`frontend_port` and `backend_port` must both be `0` when `protocol` is `All`"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMLoadBalancerRule_disableOutboundSnat(t *testing.T) {
+	var lb network.LoadBalancer
+	ri := acctest.RandInt()
+	lbRuleName := fmt.Sprintf("LbRule-%s", acctest.RandStringFromCharSet(8, acctest.CharSetAlpha))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMLoadBalancerRule_disableOutboundSnat(ri, lbRuleName, testLocation()),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMLoadBalancerExists("azurerm_lb.test", &lb),
+					testCheckAzureRMLoadBalancerRuleExists(lbRuleName, &lb),
+					resource.TestCheckResourceAttr("azurerm_lb_rule.test", "disable_outbound_snat", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMLoadBalancerRuleExists(lbRuleName string, lb *network.LoadBalancer) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		_, _, exists := findLoadBalancerRuleByName(lb, lbRuleName)
@@ -530,3 +658,96 @@ resource "azurerm_lb_rule" "test2" {
 }
 `, rInt, location, rInt, rInt, rInt, lbRuleName, rInt, lbRule2Name, rInt)
 }
+
+func testAccAzureRMLoadBalancerRule_haPorts(rInt int, lbRuleName string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  address_space       = ["10.0.0.0/16"]
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.2.0/24"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "arm-test-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                          = "one-%d"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_lb_rule" "test" {
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  name                           = "%s"
+  protocol                       = "All"
+  frontend_port                  = 0
+  backend_port                   = 0
+  frontend_ip_configuration_name = "one-%d"
+}
+`, rInt, location, rInt, rInt, rInt, lbRuleName, rInt)
+}
+
+func testAccAzureRMLoadBalancerRule_disableOutboundSnat(rInt int, lbRuleName string, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestrg-%d"
+  location = "%s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "test-ip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+  sku                          = "Standard"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "arm-test-loadbalancer-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  sku                 = "Standard"
+
+  frontend_ip_configuration {
+    name                 = "one-%d"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_backend_address_pool" "test" {
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  loadbalancer_id     = "${azurerm_lb.test.id}"
+  name                = "be-%d"
+}
+
+resource "azurerm_lb_rule" "test" {
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  name                           = "%s"
+  protocol                       = "Tcp"
+  frontend_port                  = 80
+  backend_port                   = 80
+  frontend_ip_configuration_name = "one-%d"
+  backend_address_pool_id        = "${azurerm_lb_backend_address_pool.test.id}"
+  disable_outbound_snat          = true
+}
+`, rInt, location, rInt, rInt, rInt, rInt, lbRuleName, rInt)
+}
@@ -1,6 +1,7 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -16,12 +17,14 @@ func resourceArmManagedDisk() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceArmManagedDiskCreate,
 		Read:   resourceArmManagedDiskRead,
-		Update: resourceArmManagedDiskCreate,
+		Update: resourceArmManagedDiskUpdate,
 		Delete: resourceArmManagedDiskDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmManagedDiskCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -204,6 +207,180 @@ func resourceArmManagedDiskCreate(d *schema.ResourceData, meta interface{}) erro
 	return resourceArmManagedDiskRead(d, meta)
 }
 
+func resourceArmManagedDiskUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).diskClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	resGroup := d.Get("resource_group_name").(string)
+
+	disk, err := client.Get(ctx, resGroup, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(disk.Response) {
+			return fmt.Errorf("Error Managed Disk %q (Resource Group %q) was not found", name, resGroup)
+		}
+
+		return fmt.Errorf("Error making Read request on Managed Disk %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	tags := d.Get("tags").(map[string]interface{})
+	diskUpdate := compute.DiskUpdate{
+		DiskUpdateProperties: &compute.DiskUpdateProperties{},
+		Tags:                 expandTags(tags),
+	}
+
+	// resizing a disk or changing its SKU is only possible when it's not attached to a running VM
+	requiresDeallocation := false
+
+	if d.HasChange("storage_account_type") {
+		var skuName compute.StorageAccountTypes
+		if strings.EqualFold(d.Get("storage_account_type").(string), string(compute.PremiumLRS)) {
+			skuName = compute.PremiumLRS
+		} else {
+			skuName = compute.StandardLRS
+		}
+
+		diskUpdate.Sku = &compute.DiskSku{
+			Name: skuName,
+		}
+		requiresDeallocation = true
+	}
+
+	if d.HasChange("disk_size_gb") {
+		diskSize := int32(d.Get("disk_size_gb").(int))
+		diskUpdate.DiskUpdateProperties.DiskSizeGB = &diskSize
+		requiresDeallocation = true
+	}
+
+	if d.HasChange("os_type") {
+		diskUpdate.DiskUpdateProperties.OsType = compute.OperatingSystemTypes(d.Get("os_type").(string))
+	}
+
+	if d.HasChange("encryption_settings") {
+		if v, ok := d.GetOk("encryption_settings"); ok {
+			encryptionSettings := v.([]interface{})
+			settings := encryptionSettings[0].(map[string]interface{})
+			diskUpdate.DiskUpdateProperties.EncryptionSettings = expandManagedDiskEncryptionSettings(settings)
+		}
+	}
+
+	if requiresDeallocation && disk.ManagedBy != nil && *disk.ManagedBy != "" {
+		err = resourceArmManagedDiskUpdateWithVirtualMachineDeallocated(ctx, meta, *disk.ManagedBy, func() error {
+			return updateManagedDisk(ctx, client, resGroup, name, diskUpdate)
+		})
+	} else {
+		err = updateManagedDisk(ctx, client, resGroup, name, diskUpdate)
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceArmManagedDiskRead(d, meta)
+}
+
+func updateManagedDisk(ctx context.Context, client compute.DisksClient, resGroup string, name string, diskUpdate compute.DiskUpdate) error {
+	future, err := client.Update(ctx, resGroup, name, diskUpdate)
+	if err != nil {
+		return fmt.Errorf("Error updating Managed Disk %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for update of Managed Disk %q (Resource Group %q): %+v", name, resGroup, err)
+	}
+
+	return nil
+}
+
+// resourceArmManagedDiskUpdateWithVirtualMachineDeallocated deallocates the Virtual Machine the disk
+// is attached to (if it's allocated), runs the update and then starts the Virtual Machine again if it was running
+func resourceArmManagedDiskUpdateWithVirtualMachineDeallocated(ctx context.Context, meta interface{}, virtualMachineId string, update func() error) error {
+	client := meta.(*ArmClient).vmClient
+
+	id, err := parseAzureResourceID(virtualMachineId)
+	if err != nil {
+		return err
+	}
+	resGroup := id.ResourceGroup
+	vmName := id.Path["virtualMachines"]
+
+	azureRMLockByName(vmName, virtualMachineResourceName)
+	defer azureRMUnlockByName(vmName, virtualMachineResourceName)
+
+	instanceView, err := client.InstanceView(ctx, resGroup, vmName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the Instance View for Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+	}
+
+	powerState := ""
+	if statuses := instanceView.Statuses; statuses != nil {
+		for _, status := range *statuses {
+			if status.Code == nil {
+				continue
+			}
+
+			if code := *status.Code; strings.HasPrefix(code, "PowerState/") {
+				powerState = strings.TrimPrefix(code, "PowerState/")
+				break
+			}
+		}
+	}
+
+	if !strings.EqualFold(powerState, "deallocated") {
+		log.Printf("[DEBUG] Deallocating Virtual Machine %q (Resource Group %q) to update the attached Managed Disk..", vmName, resGroup)
+		future, err := client.Deallocate(ctx, resGroup, vmName)
+		if err != nil {
+			return fmt.Errorf("Error deallocating Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+		}
+
+		err = future.WaitForCompletion(ctx, client.Client)
+		if err != nil {
+			return fmt.Errorf("Error waiting for deallocation of Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+		}
+	}
+
+	err = update()
+	if err != nil {
+		return err
+	}
+
+	if strings.EqualFold(powerState, "running") || strings.EqualFold(powerState, "starting") {
+		log.Printf("[DEBUG] Starting Virtual Machine %q (Resource Group %q)..", vmName, resGroup)
+		future, err := client.Start(ctx, resGroup, vmName)
+		if err != nil {
+			return fmt.Errorf("Error starting Virtual Machine %q (Resource Group %q): %+v", vmName, resGroup, err)
+		}
+
+		err = future.WaitForCompletion(ctx, client.Client)
+		if err != nil {
+			return fmt.Errorf("Error waiting for Virtual Machine %q (Resource Group %q) to start: %+v", vmName, resGroup, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmManagedDiskCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Id() == "" {
+		return nil
+	}
+
+	// a size which isn't known until apply reads as `0` - which isn't a valid size, so GetOk can be used to skip these
+	if _, ok := diff.GetOk("disk_size_gb"); !ok {
+		return nil
+	}
+
+	// Managed Disks can only be grown in-place, shrinking one requires a new disk
+	if diff.HasChange("disk_size_gb") {
+		old, new := diff.GetChange("disk_size_gb")
+		if new.(int) < old.(int) {
+			return diff.ForceNew("disk_size_gb")
+		}
+	}
+
+	return nil
+}
+
 func resourceArmManagedDiskRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).diskClient
 	ctx := meta.(*ArmClient).StopContext
@@ -0,0 +1,46 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceApplicationGatewayBackendAddressPoolAssociation() *schema.Resource {
+	association := networkInterfaceAssociation{
+		resourceType:            "azurerm_network_interface_application_gateway_backend_address_pool_association",
+		displayName:             "Application Gateway Backend Address Pool",
+		associatedResourceIdKey: "backend_address_pool_id",
+		modifier:                modifyNetworkInterfaceApplicationGatewayBackendAddressPoolAssociations,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceAssociationCreate(association),
+		Read:   resourceArmNetworkInterfaceAssociationRead(association),
+		Delete: resourceArmNetworkInterfaceAssociationDelete(association),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceArmNetworkInterfaceAssociationSchema("backend_address_pool_id"),
+	}
+}
+
+func modifyNetworkInterfaceApplicationGatewayBackendAddressPoolAssociations(props *network.InterfaceIPConfigurationPropertiesFormat, modify func(existing []string) []string) {
+	existing := make([]string, 0)
+	if props.ApplicationGatewayBackendAddressPools != nil {
+		for _, v := range *props.ApplicationGatewayBackendAddressPools {
+			if v.ID != nil {
+				existing = append(existing, *v.ID)
+			}
+		}
+	}
+
+	values := make([]network.ApplicationGatewayBackendAddressPool, 0)
+	for _, id := range modify(existing) {
+		values = append(values, network.ApplicationGatewayBackendAddressPool{
+			ID: utils.String(id),
+		})
+	}
+	props.ApplicationGatewayBackendAddressPools = &values
+}
@@ -0,0 +1,46 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceApplicationSecurityGroupAssociation() *schema.Resource {
+	association := networkInterfaceAssociation{
+		resourceType:            "azurerm_network_interface_application_security_group_association",
+		displayName:             "Application Security Group",
+		associatedResourceIdKey: "application_security_group_id",
+		modifier:                modifyNetworkInterfaceApplicationSecurityGroupAssociations,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceAssociationCreate(association),
+		Read:   resourceArmNetworkInterfaceAssociationRead(association),
+		Delete: resourceArmNetworkInterfaceAssociationDelete(association),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceArmNetworkInterfaceAssociationSchema("application_security_group_id"),
+	}
+}
+
+func modifyNetworkInterfaceApplicationSecurityGroupAssociations(props *network.InterfaceIPConfigurationPropertiesFormat, modify func(existing []string) []string) {
+	existing := make([]string, 0)
+	if props.ApplicationSecurityGroups != nil {
+		for _, v := range *props.ApplicationSecurityGroups {
+			if v.ID != nil {
+				existing = append(existing, *v.ID)
+			}
+		}
+	}
+
+	values := make([]network.ApplicationSecurityGroup, 0)
+	for _, id := range modify(existing) {
+		values = append(values, network.ApplicationSecurityGroup{
+			ID: utils.String(id),
+		})
+	}
+	props.ApplicationSecurityGroups = &values
+}
@@ -0,0 +1,53 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMNetworkInterfaceApplicationSecurityGroupAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_application_security_group_association.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceAssociationDestroy("azurerm_network_interface_application_security_group_association", modifyNetworkInterfaceApplicationSecurityGroupAssociations),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceApplicationSecurityGroupAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceAssociationExists(resourceName, modifyNetworkInterfaceApplicationSecurityGroupAssociations),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkInterfaceApplicationSecurityGroupAssociation_basic(rInt int, location string) string {
+	template := testAccAzureRMNetworkInterfaceAssociation_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_security_group" "test" {
+  name                = "acctestasg-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_interface_application_security_group_association" "test" {
+  network_interface_id          = "${azurerm_network_interface.test.id}"
+  ip_configuration_name         = "testconfiguration1"
+  application_security_group_id = "${azurerm_application_security_group.test.id}"
+}
+`, template, rInt)
+}
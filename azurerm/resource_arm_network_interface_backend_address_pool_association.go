@@ -0,0 +1,46 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceBackendAddressPoolAssociation() *schema.Resource {
+	association := networkInterfaceAssociation{
+		resourceType:            "azurerm_network_interface_backend_address_pool_association",
+		displayName:             "Backend Address Pool",
+		associatedResourceIdKey: "backend_address_pool_id",
+		modifier:                modifyNetworkInterfaceBackendAddressPoolAssociations,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceAssociationCreate(association),
+		Read:   resourceArmNetworkInterfaceAssociationRead(association),
+		Delete: resourceArmNetworkInterfaceAssociationDelete(association),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceArmNetworkInterfaceAssociationSchema("backend_address_pool_id"),
+	}
+}
+
+func modifyNetworkInterfaceBackendAddressPoolAssociations(props *network.InterfaceIPConfigurationPropertiesFormat, modify func(existing []string) []string) {
+	existing := make([]string, 0)
+	if props.LoadBalancerBackendAddressPools != nil {
+		for _, v := range *props.LoadBalancerBackendAddressPools {
+			if v.ID != nil {
+				existing = append(existing, *v.ID)
+			}
+		}
+	}
+
+	values := make([]network.BackendAddressPool, 0)
+	for _, id := range modify(existing) {
+		values = append(values, network.BackendAddressPool{
+			ID: utils.String(id),
+		})
+	}
+	props.LoadBalancerBackendAddressPools = &values
+}
@@ -0,0 +1,46 @@
+package azurerm
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmNetworkInterfaceNatRuleAssociation() *schema.Resource {
+	association := networkInterfaceAssociation{
+		resourceType:            "azurerm_network_interface_nat_rule_association",
+		displayName:             "NAT Rule",
+		associatedResourceIdKey: "nat_rule_id",
+		modifier:                modifyNetworkInterfaceNatRuleAssociations,
+	}
+
+	return &schema.Resource{
+		Create: resourceArmNetworkInterfaceAssociationCreate(association),
+		Read:   resourceArmNetworkInterfaceAssociationRead(association),
+		Delete: resourceArmNetworkInterfaceAssociationDelete(association),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: resourceArmNetworkInterfaceAssociationSchema("nat_rule_id"),
+	}
+}
+
+func modifyNetworkInterfaceNatRuleAssociations(props *network.InterfaceIPConfigurationPropertiesFormat, modify func(existing []string) []string) {
+	existing := make([]string, 0)
+	if props.LoadBalancerInboundNatRules != nil {
+		for _, v := range *props.LoadBalancerInboundNatRules {
+			if v.ID != nil {
+				existing = append(existing, *v.ID)
+			}
+		}
+	}
+
+	values := make([]network.InboundNatRule, 0)
+	for _, id := range modify(existing) {
+		values = append(values, network.InboundNatRule{
+			ID: utils.String(id),
+		})
+	}
+	props.LoadBalancerInboundNatRules = &values
+}
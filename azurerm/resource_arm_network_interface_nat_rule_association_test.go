@@ -0,0 +1,75 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccAzureRMNetworkInterfaceNatRuleAssociation_basic(t *testing.T) {
+	resourceName := "azurerm_network_interface_nat_rule_association.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkInterfaceAssociationDestroy("azurerm_network_interface_nat_rule_association", modifyNetworkInterfaceNatRuleAssociations),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkInterfaceNatRuleAssociation_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkInterfaceAssociationExists(resourceName, modifyNetworkInterfaceNatRuleAssociations),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkInterfaceNatRuleAssociation_basic(rInt int, location string) string {
+	template := testAccAzureRMNetworkInterfaceAssociation_template(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "static"
+}
+
+resource "azurerm_lb" "test" {
+  name                = "acctestlb-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  frontend_ip_configuration {
+    name                 = "primary"
+    public_ip_address_id = "${azurerm_public_ip.test.id}"
+  }
+}
+
+resource "azurerm_lb_nat_rule" "test" {
+  resource_group_name            = "${azurerm_resource_group.test.name}"
+  loadbalancer_id                = "${azurerm_lb.test.id}"
+  name                           = "RDPAccess"
+  protocol                       = "Tcp"
+  frontend_port                  = 3389
+  backend_port                   = 3389
+  frontend_ip_configuration_name = "primary"
+}
+
+resource "azurerm_network_interface_nat_rule_association" "test" {
+  network_interface_id  = "${azurerm_network_interface.test.id}"
+  ip_configuration_name = "testconfiguration1"
+  nat_rule_id           = "${azurerm_lb_nat_rule.test.id}"
+}
+`, template, rInt, rInt)
+}
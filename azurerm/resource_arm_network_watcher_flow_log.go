@@ -0,0 +1,267 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// Flow Logs don't have an ID of their own, so we combine the ID of the
+// Network Watcher and the ID of the Network Security Group
+const networkWatcherFlowLogIdSeparator = "/networkSecurityGroupId"
+
+func resourceArmNetworkWatcherFlowLog() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Read:   resourceArmNetworkWatcherFlowLogRead,
+		Update: resourceArmNetworkWatcherFlowLogCreateUpdate,
+		Delete: resourceArmNetworkWatcherFlowLogDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"network_watcher_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"network_security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"storage_account_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+
+			"retention_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+
+						"days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 365),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmNetworkWatcherFlowLogCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	watcherClient := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	watcherName := d.Get("network_watcher_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	networkSecurityGroupId := d.Get("network_security_group_id").(string)
+	storageAccountId := d.Get("storage_account_id").(string)
+	enabled := d.Get("enabled").(bool)
+
+	watcher, err := watcherClient.Get(ctx, resourceGroup, watcherName)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Network Watcher %q (Resource Group %q): %+v", watcherName, resourceGroup, err)
+	}
+	if watcher.ID == nil {
+		return fmt.Errorf("Cannot read Network Watcher %q (Resource Group %q) ID", watcherName, resourceGroup)
+	}
+
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID:       utils.String(storageAccountId),
+			Enabled:         utils.Bool(enabled),
+			RetentionPolicy: expandArmNetworkWatcherFlowLogRetentionPolicy(d),
+		},
+	}
+
+	future, err := watcherClient.SetFlowLogConfiguration(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return fmt.Errorf("Error configuring Flow Log for Network Security Group %q (Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, watcherClient.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for the configuration of the Flow Log for Network Security Group %q (Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	d.SetId(*watcher.ID + networkWatcherFlowLogIdSeparator + networkSecurityGroupId)
+
+	return resourceArmNetworkWatcherFlowLogRead(d, meta)
+}
+
+func resourceArmNetworkWatcherFlowLogRead(d *schema.ResourceData, meta interface{}) error {
+	watcherClient := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	watcherId, networkSecurityGroupId, err := parseArmNetworkWatcherFlowLogId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(watcherId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	watcherName := id.Path["networkWatchers"]
+
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+	}
+	future, err := watcherClient.GetFlowLogStatus(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			log.Printf("[WARN] Flow Log for Network Security Group %q (Watcher %q / Resource Group %q) not found - removing from state", networkSecurityGroupId, watcherName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error retrieving Flow Log Status for Network Security Group %q (Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, watcherClient.Client)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			log.Printf("[WARN] Flow Log for Network Security Group %q (Watcher %q / Resource Group %q) not found - removing from state", networkSecurityGroupId, watcherName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error waiting for the Flow Log Status for Network Security Group %q (Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	flowLog, err := future.Result(watcherClient)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Flow Log Status for Network Security Group %q (Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	d.Set("network_watcher_name", watcherName)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("network_security_group_id", networkSecurityGroupId)
+
+	if props := flowLog.FlowLogProperties; props != nil {
+		d.Set("storage_account_id", props.StorageID)
+		d.Set("enabled", props.Enabled)
+
+		if err := d.Set("retention_policy", flattenArmNetworkWatcherFlowLogRetentionPolicy(props.RetentionPolicy)); err != nil {
+			return fmt.Errorf("Error flattening `retention_policy`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmNetworkWatcherFlowLogDelete(d *schema.ResourceData, meta interface{}) error {
+	watcherClient := meta.(*ArmClient).watcherClient
+	ctx := meta.(*ArmClient).StopContext
+
+	watcherId, networkSecurityGroupId, err := parseArmNetworkWatcherFlowLogId(d.Id())
+	if err != nil {
+		return err
+	}
+
+	id, err := parseAzureResourceID(watcherId)
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	watcherName := id.Path["networkWatchers"]
+
+	// Flow Logs can't be deleted, instead they're disabled - however the Storage Account is still required
+	parameters := network.FlowLogInformation{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+		FlowLogProperties: &network.FlowLogProperties{
+			StorageID: utils.String(d.Get("storage_account_id").(string)),
+			Enabled:   utils.Bool(false),
+		},
+	}
+
+	future, err := watcherClient.SetFlowLogConfiguration(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error disabling Flow Log for Network Security Group %q (Watcher %q / Resource Group %q): %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, watcherClient.Client)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+
+		return fmt.Errorf("Error waiting for the Flow Log for Network Security Group %q (Watcher %q / Resource Group %q) to be disabled: %+v", networkSecurityGroupId, watcherName, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func parseArmNetworkWatcherFlowLogId(input string) (string, string, error) {
+	segments := strings.Split(input, networkWatcherFlowLogIdSeparator)
+	if len(segments) != 2 || segments[0] == "" || segments[1] == "" {
+		return "", "", fmt.Errorf("Expected the Flow Log ID to be in the format `{networkWatcherId}%s{networkSecurityGroupId}` but got %q", networkWatcherFlowLogIdSeparator, input)
+	}
+
+	return segments[0], segments[1], nil
+}
+
+func expandArmNetworkWatcherFlowLogRetentionPolicy(d *schema.ResourceData) *network.RetentionPolicyParameters {
+	policies := d.Get("retention_policy").([]interface{})
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+
+	policy := policies[0].(map[string]interface{})
+	enabled := policy["enabled"].(bool)
+	days := policy["days"].(int)
+
+	return &network.RetentionPolicyParameters{
+		Enabled: utils.Bool(enabled),
+		Days:    utils.Int32(int32(days)),
+	}
+}
+
+func flattenArmNetworkWatcherFlowLogRetentionPolicy(input *network.RetentionPolicyParameters) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	result := make(map[string]interface{}, 0)
+
+	if enabled := input.Enabled; enabled != nil {
+		result["enabled"] = *enabled
+	}
+	if days := input.Days; days != nil {
+		result["days"] = int(*days)
+	}
+
+	return []interface{}{result}
+}
@@ -0,0 +1,240 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestParseArmNetworkWatcherFlowLogId(t *testing.T) {
+	watcherId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group1/providers/Microsoft.Network/networkWatchers/watcher1"
+	nsgId := "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/group2/providers/Microsoft.Network/networkSecurityGroups/nsg1"
+
+	testCases := []struct {
+		Input             string
+		ExpectedWatcherId string
+		ExpectedNsgId     string
+		ExpectError       bool
+	}{
+		{
+			Input:       "",
+			ExpectError: true,
+		},
+		{
+			Input:       watcherId,
+			ExpectError: true,
+		},
+		{
+			Input:       watcherId + "/networkSecurityGroupId",
+			ExpectError: true,
+		},
+		{
+			Input:             watcherId + "/networkSecurityGroupId" + nsgId,
+			ExpectedWatcherId: watcherId,
+			ExpectedNsgId:     nsgId,
+		},
+	}
+
+	for _, tc := range testCases {
+		actualWatcherId, actualNsgId, err := parseArmNetworkWatcherFlowLogId(tc.Input)
+		if err != nil {
+			if tc.ExpectError {
+				continue
+			}
+
+			t.Fatalf("Expected no error for %q but got: %+v", tc.Input, err)
+		}
+
+		if tc.ExpectError {
+			t.Fatalf("Expected an error for %q but didn't get one", tc.Input)
+		}
+
+		if actualWatcherId != tc.ExpectedWatcherId {
+			t.Fatalf("Expected the Watcher ID to be %q but got %q", tc.ExpectedWatcherId, actualWatcherId)
+		}
+
+		if actualNsgId != tc.ExpectedNsgId {
+			t.Fatalf("Expected the Network Security Group ID to be %q but got %q", tc.ExpectedNsgId, actualNsgId)
+		}
+	}
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basic(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location, true, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_update(t *testing.T) {
+	resourceName := "azurerm_network_watcher_flow_log.test"
+
+	ri := acctest.RandInt()
+	rs := acctest.RandString(5)
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMNetworkWatcherFlowLogDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location, true, 7),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "7"),
+				),
+			},
+			{
+				Config: testAccAzureRMNetworkWatcherFlowLog_basicConfig(ri, rs, location, false, 30),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMNetworkWatcherFlowLogExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "retention_policy.0.days", "30"),
+				),
+			},
+		},
+	})
+}
+
+func testGetAzureRMNetworkWatcherFlowLog(resourceGroup string, watcherName string, networkSecurityGroupId string) (*network.FlowLogInformation, error) {
+	client := testAccProvider.Meta().(*ArmClient).watcherClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	parameters := network.FlowLogStatusParameters{
+		TargetResourceID: utils.String(networkSecurityGroupId),
+	}
+	future, err := client.GetFlowLogStatus(ctx, resourceGroup, watcherName, parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := future.WaitForCompletion(ctx, client.Client); err != nil {
+		return nil, err
+	}
+
+	flowLog, err := future.Result(client)
+	if err != nil {
+		return nil, err
+	}
+
+	return &flowLog, nil
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		watcherName := rs.Primary.Attributes["network_watcher_name"]
+		networkSecurityGroupId := rs.Primary.Attributes["network_security_group_id"]
+
+		flowLog, err := testGetAzureRMNetworkWatcherFlowLog(resourceGroup, watcherName, networkSecurityGroupId)
+		if err != nil {
+			return fmt.Errorf("Bad: Get Flow Log Status on watcherClient: %+v", err)
+		}
+
+		if flowLog.FlowLogProperties == nil || flowLog.FlowLogProperties.StorageID == nil {
+			return fmt.Errorf("Bad: Flow Log for Network Security Group %q isn't configured", networkSecurityGroupId)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMNetworkWatcherFlowLogDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_network_watcher_flow_log" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		watcherName := rs.Primary.Attributes["network_watcher_name"]
+		networkSecurityGroupId := rs.Primary.Attributes["network_security_group_id"]
+
+		flowLog, err := testGetAzureRMNetworkWatcherFlowLog(resourceGroup, watcherName, networkSecurityGroupId)
+		if err != nil {
+			// the Network Watcher or Network Security Group has been deleted
+			return nil
+		}
+
+		if props := flowLog.FlowLogProperties; props != nil && props.Enabled != nil && *props.Enabled {
+			return fmt.Errorf("Flow Log for Network Security Group %q is still enabled", networkSecurityGroupId)
+		}
+	}
+
+	return nil
+}
+
+func testAccAzureRMNetworkWatcherFlowLog_basicConfig(rInt int, rString string, location string, enabled bool, retentionDays int) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_network_watcher" "test" {
+  name                = "acctestnw-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_network_security_group" "test" {
+  name                = "acctestnsg-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = "${azurerm_resource_group.test.name}"
+  location                 = "${azurerm_resource_group.test.location}"
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name      = "${azurerm_network_watcher.test.name}"
+  resource_group_name       = "${azurerm_resource_group.test.name}"
+  network_security_group_id = "${azurerm_network_security_group.test.id}"
+  storage_account_id        = "${azurerm_storage_account.test.id}"
+  enabled                   = %t
+
+  retention_policy {
+    enabled = true
+    days    = %d
+  }
+}
+`, rInt, location, rInt, rInt, rString, enabled, retentionDays)
+}
@@ -31,6 +31,14 @@ func TestAccAzureRMNetworkWatcher(t *testing.T) {
 			"storageAccountAndLocalDisk": testAccAzureRMPacketCapture_storageAccountAndLocalDisk,
 			"withFilters":                testAccAzureRMPacketCapture_withFilters,
 		},
+		"FlowLog": {
+			"basic":  testAccAzureRMNetworkWatcherFlowLog_basic,
+			"update": testAccAzureRMNetworkWatcherFlowLog_update,
+		},
+		"DataSource": {
+			"ipFlowVerify": testAccDataSourceAzureRMNetworkWatcherIPFlowVerify_basic,
+			"nextHop":      testAccDataSourceAzureRMNetworkWatcherNextHop_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -53,6 +53,18 @@ func resourceArmPublicIp() *schema.Resource {
 				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
 			},
 
+			"ip_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  string(network.IPv4),
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.IPv4),
+					string(network.IPv6),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
 			"idle_timeout_in_minutes": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -120,6 +132,7 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 	zones := expandZones(d.Get("zones").([]interface{}))
 
 	ipAllocationMethod := network.IPAllocationMethod(d.Get("public_ip_address_allocation").(string))
+	ipVersion := network.IPVersion(d.Get("ip_version").(string))
 
 	if strings.ToLower(string(sku.Name)) == "standard" {
 		if strings.ToLower(string(ipAllocationMethod)) != "static" {
@@ -127,8 +140,15 @@ func resourceArmPublicIpCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if strings.ToLower(string(ipVersion)) == "ipv6" {
+		if strings.ToLower(string(ipAllocationMethod)) != "dynamic" {
+			return fmt.Errorf("Dynamic IP allocation must be used when creating IPv6 public IP addresses.")
+		}
+	}
+
 	properties := network.PublicIPAddressPropertiesFormat{
 		PublicIPAllocationMethod: ipAllocationMethod,
+		PublicIPAddressVersion:   ipVersion,
 	}
 
 	dnl, hasDnl := d.GetOk("domain_name_label")
@@ -223,6 +243,7 @@ func resourceArmPublicIpRead(d *schema.ResourceData, meta interface{}) error {
 
 	if props := resp.PublicIPAddressPropertiesFormat; props != nil {
 		d.Set("public_ip_address_allocation", strings.ToLower(string(props.PublicIPAllocationMethod)))
+		d.Set("ip_version", string(props.PublicIPAddressVersion))
 
 		if settings := props.DNSSettings; settings != nil {
 			if fqdn := settings.Fqdn; fqdn != nil {
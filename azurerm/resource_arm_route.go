@@ -18,6 +18,7 @@ func resourceArmRoute() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceArmRouteCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -112,6 +113,36 @@ func resourceArmRouteCreateUpdate(d *schema.ResourceData, meta interface{}) erro
 	return resourceArmRouteRead(d, meta)
 }
 
+func resourceArmRouteCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" {
+		return nil
+	}
+
+	name := diff.Get("name").(string)
+	rtName := diff.Get("route_table_name").(string)
+	resGroup := diff.Get("resource_group_name").(string)
+
+	// these may not be known until apply time, in which case there's nothing to check
+	if name == "" || rtName == "" || resGroup == "" {
+		return nil
+	}
+
+	client := meta.(*ArmClient).routesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	// a Route with this name may already exist, for example as an in-line `route` within an
+	// `azurerm_route_table` resource - creating it would silently overwrite the existing Route
+	existing, err := client.Get(ctx, resGroup, rtName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(existing.Response) {
+			return nil
+		}
+		return fmt.Errorf("Error checking for the presence of an existing Route %q (Route Table %q / Resource Group %q): %+v", name, rtName, resGroup, err)
+	}
+
+	return fmt.Errorf("A Route named %q already exists in Route Table %q (Resource Group %q) - to be managed via Terraform this resource needs to be imported into the State, and any in-line `route` blocks with the same name removed from the `azurerm_route_table` resource.", name, rtName, resGroup)
+}
+
 func resourceArmRouteRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).routesClient
 	ctx := meta.(*ArmClient).StopContext
@@ -0,0 +1,262 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+var routeFilterResourceName = "azurerm_route_filter"
+
+func resourceArmRouteFilter() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRouteFilterCreateUpdate,
+		Read:   resourceArmRouteFilterRead,
+		Update: resourceArmRouteFilterCreateUpdate,
+		Delete: resourceArmRouteFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"location": locationSchema(),
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"rule": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+
+						"access": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								string(network.Allow),
+							}, false),
+						},
+
+						"rule_type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"Community",
+							}, false),
+						},
+
+						"communities": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"tags": tagsSchema(),
+		},
+	}
+}
+
+func resourceArmRouteFilterCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFiltersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	log.Printf("[INFO] preparing arguments for AzureRM Route Filter creation.")
+
+	name := d.Get("name").(string)
+	location := azureRMNormalizeLocation(d.Get("location").(string))
+	resourceGroup := d.Get("resource_group_name").(string)
+	tags := d.Get("tags").(map[string]interface{})
+
+	azureRMLockByName(name, routeFilterResourceName)
+	defer azureRMUnlockByName(name, routeFilterResourceName)
+
+	rules := expandRouteFilterRules(d)
+	parameters := network.RouteFilter{
+		Name:     utils.String(name),
+		Location: utils.String(location),
+		RouteFilterPropertiesFormat: &network.RouteFilterPropertiesFormat{
+			Rules: &rules,
+		},
+		Tags: expandTags(tags),
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, name, parameters)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for completion of Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Route Filter %q (Resource Group %q) ID", name, resourceGroup)
+	}
+
+	d.SetId(*read.ID)
+
+	return resourceArmRouteFilterRead(d, meta)
+}
+
+func resourceArmRouteFilterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFiltersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["routeFilters"]
+
+	resp, err := client.Get(ctx, resourceGroup, name, "")
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Route Filter %q was not found in Resource Group %q - removing from state", name, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	d.Set("name", resp.Name)
+	d.Set("resource_group_name", resourceGroup)
+	if location := resp.Location; location != nil {
+		d.Set("location", azureRMNormalizeLocation(*location))
+	}
+
+	if props := resp.RouteFilterPropertiesFormat; props != nil {
+		if err := d.Set("rule", flattenRouteFilterRules(props.Rules)); err != nil {
+			return fmt.Errorf("Error flattening `rule`: %+v", err)
+		}
+	}
+
+	flattenAndSetTags(d, resp.Tags)
+
+	return nil
+}
+
+func resourceArmRouteFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFiltersClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	name := id.Path["routeFilters"]
+
+	azureRMLockByName(name, routeFilterResourceName)
+	defer azureRMUnlockByName(name, routeFilterResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for deletion of Route Filter %q (Resource Group %q): %+v", name, resourceGroup, err)
+	}
+
+	return nil
+}
+
+func expandRouteFilterRules(d *schema.ResourceData) []network.RouteFilterRule {
+	configs := d.Get("rule").([]interface{})
+	rules := make([]network.RouteFilterRule, 0, len(configs))
+
+	for _, configRaw := range configs {
+		data := configRaw.(map[string]interface{})
+
+		name := data["name"].(string)
+		access := data["access"].(string)
+		ruleType := data["rule_type"].(string)
+
+		communities := make([]string, 0)
+		for _, community := range data["communities"].([]interface{}) {
+			communities = append(communities, community.(string))
+		}
+
+		rule := network.RouteFilterRule{
+			Name: utils.String(name),
+			RouteFilterRulePropertiesFormat: &network.RouteFilterRulePropertiesFormat{
+				Access:              network.Access(access),
+				RouteFilterRuleType: utils.String(ruleType),
+				Communities:         &communities,
+			},
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func flattenRouteFilterRules(input *[]network.RouteFilterRule) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, rule := range *input {
+		r := make(map[string]interface{})
+
+		if name := rule.Name; name != nil {
+			r["name"] = *name
+		}
+
+		if props := rule.RouteFilterRulePropertiesFormat; props != nil {
+			r["access"] = string(props.Access)
+			if ruleType := props.RouteFilterRuleType; ruleType != nil {
+				r["rule_type"] = *ruleType
+			}
+			r["communities"] = flattenRouteFilterRuleCommunities(props.Communities)
+		}
+
+		results = append(results, r)
+	}
+
+	return results
+}
+
+func flattenRouteFilterRuleCommunities(input *[]string) []interface{} {
+	communities := make([]interface{}, 0)
+	if input == nil {
+		return communities
+	}
+
+	for _, community := range *input {
+		communities = append(communities, community)
+	}
+
+	return communities
+}
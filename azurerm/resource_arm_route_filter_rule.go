@@ -0,0 +1,188 @@
+package azurerm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/Azure/azure-sdk-for-go/services/network/mgmt/2017-09-01/network"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/response"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmRouteFilterRule() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmRouteFilterRuleCreateUpdate,
+		Read:   resourceArmRouteFilterRuleRead,
+		Update: resourceArmRouteFilterRuleCreateUpdate,
+		Delete: resourceArmRouteFilterRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"resource_group_name": resourceGroupNameSchema(),
+
+			"route_filter_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+
+			"access": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(network.Allow),
+				}, false),
+			},
+
+			"rule_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Community",
+				}, false),
+			},
+
+			"communities": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceArmRouteFilterRuleCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	filtersClient := meta.(*ArmClient).routeFiltersClient
+	client := meta.(*ArmClient).routeFilterRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	name := d.Get("name").(string)
+	filterName := d.Get("route_filter_name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	access := d.Get("access").(string)
+	ruleType := d.Get("rule_type").(string)
+
+	communities := make([]string, 0)
+	for _, community := range d.Get("communities").([]interface{}) {
+		communities = append(communities, community.(string))
+	}
+
+	azureRMLockByName(filterName, routeFilterResourceName)
+	defer azureRMUnlockByName(filterName, routeFilterResourceName)
+
+	// Route Filter Rules must be created in the same location as the Route Filter they belong to
+	filter, err := filtersClient.Get(ctx, resourceGroup, filterName, "")
+	if err != nil {
+		return fmt.Errorf("Error retrieving Route Filter %q (Resource Group %q): %+v", filterName, resourceGroup, err)
+	}
+
+	rule := network.RouteFilterRule{
+		Name:     utils.String(name),
+		Location: filter.Location,
+		RouteFilterRulePropertiesFormat: &network.RouteFilterRulePropertiesFormat{
+			Access:              network.Access(access),
+			RouteFilterRuleType: utils.String(ruleType),
+			Communities:         &communities,
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, filterName, name, rule)
+	if err != nil {
+		return fmt.Errorf("Error Creating/Updating Route Filter Rule %q (Route Filter %q / Resource Group %q): %+v", name, filterName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for completion of Route Filter Rule %q (Route Filter %q / Resource Group %q): %+v", name, filterName, resourceGroup, err)
+	}
+
+	read, err := client.Get(ctx, resourceGroup, filterName, name)
+	if err != nil {
+		return fmt.Errorf("Error retrieving Route Filter Rule %q (Route Filter %q / Resource Group %q): %+v", name, filterName, resourceGroup, err)
+	}
+	if read.ID == nil {
+		return fmt.Errorf("Cannot read Route Filter Rule %q (Route Filter %q / Resource Group %q) ID", name, filterName, resourceGroup)
+	}
+	d.SetId(*read.ID)
+
+	return resourceArmRouteFilterRuleRead(d, meta)
+}
+
+func resourceArmRouteFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFilterRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	filterName := id.Path["routeFilters"]
+	name := id.Path["routeFilterRules"]
+
+	resp, err := client.Get(ctx, resourceGroup, filterName, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[DEBUG] Route Filter Rule %q was not found in Route Filter %q / Resource Group %q - removing from state", name, filterName, resourceGroup)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error making Read request on Route Filter Rule %q (Route Filter %q / Resource Group %q): %+v", name, filterName, resourceGroup, err)
+	}
+
+	d.Set("name", name)
+	d.Set("resource_group_name", resourceGroup)
+	d.Set("route_filter_name", filterName)
+
+	if props := resp.RouteFilterRulePropertiesFormat; props != nil {
+		d.Set("access", string(props.Access))
+		d.Set("rule_type", props.RouteFilterRuleType)
+
+		if err := d.Set("communities", flattenRouteFilterRuleCommunities(props.Communities)); err != nil {
+			return fmt.Errorf("Error flattening `communities`: %+v", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmRouteFilterRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*ArmClient).routeFilterRulesClient
+	ctx := meta.(*ArmClient).StopContext
+
+	id, err := parseAzureResourceID(d.Id())
+	if err != nil {
+		return err
+	}
+	resourceGroup := id.ResourceGroup
+	filterName := id.Path["routeFilters"]
+	name := id.Path["routeFilterRules"]
+
+	azureRMLockByName(filterName, routeFilterResourceName)
+	defer azureRMUnlockByName(filterName, routeFilterResourceName)
+
+	future, err := client.Delete(ctx, resourceGroup, filterName, name)
+	if err != nil {
+		if response.WasNotFound(future.Response()) {
+			return nil
+		}
+		return fmt.Errorf("Error deleting Route Filter Rule %q (Route Filter %q / Resource Group %q): %+v", name, filterName, resourceGroup, err)
+	}
+
+	err = future.WaitForCompletion(ctx, client.Client)
+	if err != nil {
+		return fmt.Errorf("Error waiting for deletion of Route Filter Rule %q (Route Filter %q / Resource Group %q): %+v", name, filterName, resourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,150 @@
+package azurerm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMRouteFilterRule_basic(t *testing.T) {
+	resourceName := "azurerm_route_filter_rule.test"
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRouteFilterRule_basic(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "communities.#", "1"),
+				),
+			},
+			{
+				Config: testAccAzureRMRouteFilterRule_updated(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "communities.#", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMRouteFilterRuleExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %q", name)
+		}
+
+		name := rs.Primary.Attributes["name"]
+		filterName := rs.Primary.Attributes["route_filter_name"]
+		resourceGroup, hasResourceGroup := rs.Primary.Attributes["resource_group_name"]
+		if !hasResourceGroup {
+			return fmt.Errorf("Bad: no resource group found in state for route filter rule: %q", name)
+		}
+
+		client := testAccProvider.Meta().(*ArmClient).routeFilterRulesClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, filterName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Bad: Route Filter Rule %q (Route Filter %q / resource group: %q) does not exist", name, filterName, resourceGroup)
+			}
+
+			return fmt.Errorf("Bad: Get on routeFilterRulesClient: %+v", err)
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMRouteFilterRuleDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*ArmClient).routeFilterRulesClient
+	ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_route_filter_rule" {
+			continue
+		}
+
+		name := rs.Primary.Attributes["name"]
+		filterName := rs.Primary.Attributes["route_filter_name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		resp, err := client.Get(ctx, resourceGroup, filterName, name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Route Filter Rule still exists:\n%#v", resp.RouteFilterRulePropertiesFormat)
+	}
+
+	return nil
+}
+
+func testAccAzureRMRouteFilterRule_basic(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_filter" "test" {
+  name                = "acctestrf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_route_filter_rule" "test" {
+  name                = "acctestrfr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  route_filter_name   = "${azurerm_route_filter.test.name}"
+  access              = "Allow"
+  rule_type           = "Community"
+  communities         = ["12076:52004"]
+}
+`, rInt, location, rInt, rInt)
+}
+
+func testAccAzureRMRouteFilterRule_updated(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_route_filter" "test" {
+  name                = "acctestrf%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_route_filter_rule" "test" {
+  name                = "acctestrfr%d"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  route_filter_name   = "${azurerm_route_filter.test.name}"
+  access              = "Allow"
+  rule_type           = "Community"
+  communities         = ["12076:52004", "12076:52005"]
+}
+`, rInt, location, rInt, rInt)
+}
@@ -3,6 +3,7 @@ package azurerm
 import (
 	"fmt"
 	"net/http"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -79,6 +80,29 @@ func TestAccAzureRMRoute_multipleRoutes(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMRoute_conflictsWithInlineRoute(t *testing.T) {
+	ri := acctest.RandInt()
+	location := testLocation()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMRouteTable_singleRoute(ri, location),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMRouteTableExists("azurerm_route_table.test"),
+				),
+			},
+			{
+				Config:      testAccAzureRMRoute_conflictsWithInlineRoute(ri, location),
+				ExpectError: regexp.MustCompile("already exists in Route Table"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMRouteExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 
@@ -215,3 +239,18 @@ resource "azurerm_route" "test1" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMRoute_conflictsWithInlineRoute(rInt int, location string) string {
+	config := testAccAzureRMRouteTable_singleRoute(rInt, location)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_route" "test" {
+  name                = "route1"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  route_table_name    = "${azurerm_route_table.test.name}"
+  address_prefix      = "10.1.0.0/16"
+  next_hop_type       = "vnetlocal"
+}
+`, config)
+}
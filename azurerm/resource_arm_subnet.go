@@ -79,9 +79,6 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 	resGroup := d.Get("resource_group_name").(string)
 	addressPrefix := d.Get("address_prefix").(string)
 
-	azureRMLockByName(vnetName, virtualNetworkResourceName)
-	defer azureRMUnlockByName(vnetName, virtualNetworkResourceName)
-
 	properties := network.SubnetPropertiesFormat{
 		AddressPrefix: &addressPrefix,
 	}
@@ -116,6 +113,14 @@ func resourceArmSubnetCreate(d *schema.ResourceData, meta interface{}) error {
 		defer azureRMUnlockByName(routeTableName, routeTableResourceName)
 	}
 
+	// locks are acquired in the same order as the delete (NSG, Route Table, Virtual Network, Subnet)
+	// so that parallel operations against the same Virtual Network can't deadlock
+	azureRMLockByName(vnetName, virtualNetworkResourceName)
+	defer azureRMUnlockByName(vnetName, virtualNetworkResourceName)
+
+	azureRMLockByName(name, subnetResourceName)
+	defer azureRMUnlockByName(name, subnetResourceName)
+
 	serviceEndpoints, serviceEndpointsErr := expandAzureRmServiceEndpoints(d)
 	if serviceEndpointsErr != nil {
 		return fmt.Errorf("Error Building list of Service Endpoints: %+v", serviceEndpointsErr)
@@ -22,6 +22,7 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: resourceArmTrafficManagerProfileCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -104,6 +105,24 @@ func resourceArmTrafficManagerProfile() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						"interval_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      30,
+							ValidateFunc: validateIntInSlice([]int{10, 30}),
+						},
+						"timeout_in_seconds": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      10,
+							ValidateFunc: validation.IntBetween(5, 10),
+						},
+						"tolerated_number_of_failures": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      3,
+							ValidateFunc: validation.IntBetween(0, 9),
+						},
 					},
 				},
 				Set: resourceAzureRMTrafficManagerMonitorConfigHash,
@@ -237,14 +256,50 @@ func expandArmTrafficManagerMonitorConfig(d *schema.ResourceData) *trafficmanage
 	proto := monitor["protocol"].(string)
 	port := int64(monitor["port"].(int))
 	path := monitor["path"].(string)
+	interval := int64(monitor["interval_in_seconds"].(int))
+	timeout := int64(monitor["timeout_in_seconds"].(int))
+	toleratedFailures := int64(monitor["tolerated_number_of_failures"].(int))
 
 	return &trafficmanager.MonitorConfig{
-		Protocol: trafficmanager.MonitorProtocol(proto),
-		Port:     &port,
-		Path:     &path,
+		Protocol:                  trafficmanager.MonitorProtocol(proto),
+		Port:                      &port,
+		Path:                      &path,
+		IntervalInSeconds:         &interval,
+		TimeoutInSeconds:          &timeout,
+		ToleratedNumberOfFailures: &toleratedFailures,
 	}
 }
 
+func resourceArmTrafficManagerProfileCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	monitorSets := diff.Get("monitor_config").(*schema.Set).List()
+	for _, raw := range monitorSets {
+		monitor := raw.(map[string]interface{})
+
+		// values which aren't known until apply are `0` here, which is outside of the range either field accepts
+		interval := monitor["interval_in_seconds"].(int)
+		timeout := monitor["timeout_in_seconds"].(int)
+		if interval == 0 || timeout == 0 {
+			continue
+		}
+
+		if err := validateArmTrafficManagerMonitorConfig(interval, timeout); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// the `timeout_in_seconds` default of `10` is only valid with an `interval_in_seconds` of `30`,
+// so Fast Probing (an interval of `10`) needs the timeout to be set explicitly
+func validateArmTrafficManagerMonitorConfig(interval int, timeout int) error {
+	if timeout >= interval {
+		return fmt.Errorf("`timeout_in_seconds` (%d) must be less than `interval_in_seconds` (%d) - when `interval_in_seconds` is `10` the `timeout_in_seconds` must be set to between `5` and `9`", timeout, interval)
+	}
+
+	return nil
+}
+
 func expandArmTrafficManagerDNSConfig(d *schema.ResourceData) *trafficmanager.DNSConfig {
 	dnsSets := d.Get("dns_config").(*schema.Set).List()
 	dns := dnsSets[0].(map[string]interface{})
@@ -277,6 +332,18 @@ func flattenAzureRMTrafficManagerProfileMonitorConfig(cfg *trafficmanager.Monito
 		result["path"] = *cfg.Path
 	}
 
+	if cfg.IntervalInSeconds != nil {
+		result["interval_in_seconds"] = int(*cfg.IntervalInSeconds)
+	}
+
+	if cfg.TimeoutInSeconds != nil {
+		result["timeout_in_seconds"] = int(*cfg.TimeoutInSeconds)
+	}
+
+	if cfg.ToleratedNumberOfFailures != nil {
+		result["tolerated_number_of_failures"] = int(*cfg.ToleratedNumberOfFailures)
+	}
+
 	return []interface{}{result}
 }
 
@@ -301,5 +368,17 @@ func resourceAzureRMTrafficManagerMonitorConfigHash(v interface{}) int {
 		buf.WriteString(fmt.Sprintf("%s-", m["path"].(string)))
 	}
 
+	if v, ok := m["interval_in_seconds"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+
+	if v, ok := m["timeout_in_seconds"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+
+	if v, ok := m["tolerated_number_of_failures"]; ok {
+		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
+	}
+
 	return hashcode.String(buf.String())
 }
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -181,6 +183,50 @@ func TestAccAzureRMTrafficManagerProfile_withTags(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMTrafficManagerProfile_fastFailover(t *testing.T) {
+	resourceName := "azurerm_traffic_manager_profile.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMTrafficManagerProfile_fastFailover(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMTrafficManagerProfileExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "monitor_config.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccAzureRMTrafficManagerProfile_fastFailoverDefaultTimeout(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMTrafficManagerProfile_fastFailover(ri, testLocation())
+	config = strings.Replace(config, "    timeout_in_seconds           = 9\n", "", 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMTrafficManagerProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`timeout_in_seconds` \\(10\\) must be less than `interval_in_seconds` \\(10\\)"),
+			},
+		},
+	})
+}
+
 func testCheckAzureRMTrafficManagerProfileExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		// Ensure we have enough information in state to look up in API
@@ -426,3 +472,32 @@ resource "azurerm_traffic_manager_profile" "test" {
 }
 `, rInt, location, rInt, rInt)
 }
+
+func testAccAzureRMTrafficManagerProfile_fastFailover(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_traffic_manager_profile" "test" {
+  name                   = "acctesttmp%d"
+  resource_group_name    = "${azurerm_resource_group.test.name}"
+  traffic_routing_method = "Weighted"
+
+  dns_config {
+    relative_name = "acctesttmp%d"
+    ttl           = 30
+  }
+
+  monitor_config {
+    protocol                     = "https"
+    port                         = 443
+    path                         = "/"
+    interval_in_seconds          = 10
+    timeout_in_seconds           = 9
+    tolerated_number_of_failures = 0
+  }
+}
+`, rInt, location, rInt, rInt)
+}
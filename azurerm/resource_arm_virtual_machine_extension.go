@@ -1,9 +1,13 @@
 package azurerm
 
 import (
+	"context"
 	"fmt"
+	"log"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
+	"github.com/hashicorp/terraform/helper/resource"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/structure"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -73,6 +77,16 @@ func resourceArmVirtualMachineExtensions() *schema.Resource {
 				DiffSuppressFunc: structure.SuppressJsonDiff,
 			},
 
+			// this isn't returned by the API, we instead wait for these extensions to be provisioned
+			"provision_after_extensions": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.NoZeroValues,
+				},
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -119,6 +133,26 @@ func resourceArmVirtualMachineExtensionsCreate(d *schema.ResourceData, meta inte
 		extension.VirtualMachineExtensionProperties.ProtectedSettings = &protectedSettings
 	}
 
+	if v, ok := d.GetOk("provision_after_extensions"); ok {
+		for _, dependency := range v.([]interface{}) {
+			dependencyName := dependency.(string)
+			log.Printf("[DEBUG] Waiting for Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q) to be provisioned..", dependencyName, vmName, resGroup)
+			stateConf := &resource.StateChangeConf{
+				Pending: []string{"Creating", "Updating"},
+				Target:  []string{"Succeeded"},
+				Refresh: virtualMachineExtensionStateRefreshFunc(ctx, client, resGroup, vmName, dependencyName),
+				Timeout: 60 * time.Minute,
+			}
+			if _, err := stateConf.WaitForState(); err != nil {
+				return fmt.Errorf("Error waiting for Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q) to be provisioned: %+v", dependencyName, vmName, resGroup, err)
+			}
+		}
+	}
+
+	// Azure only allows a single extension operation at a time on a Virtual Machine
+	azureRMLockByName(vmName, virtualMachineResourceName)
+	defer azureRMUnlockByName(vmName, virtualMachineResourceName)
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, vmName, name, extension)
 	if err != nil {
 		return err
@@ -188,9 +222,6 @@ func resourceArmVirtualMachineExtensionsRead(d *schema.ResourceData, meta interf
 		}
 	}
 
-	if resp.VirtualMachineExtensionProperties.Settings != nil {
-	}
-
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -208,6 +239,9 @@ func resourceArmVirtualMachineExtensionsDelete(d *schema.ResourceData, meta inte
 	name := id.Path["extensions"]
 	vmName := id.Path["virtualMachines"]
 
+	azureRMLockByName(vmName, virtualMachineResourceName)
+	defer azureRMUnlockByName(vmName, virtualMachineResourceName)
+
 	future, err := client.Delete(ctx, resGroup, vmName, name)
 	if err != nil {
 		return err
@@ -220,3 +254,23 @@ func resourceArmVirtualMachineExtensionsDelete(d *schema.ResourceData, meta inte
 
 	return nil
 }
+
+func virtualMachineExtensionStateRefreshFunc(ctx context.Context, client compute.VirtualMachineExtensionsClient, resourceGroup string, vmName string, name string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		res, err := client.Get(ctx, resourceGroup, vmName, name, "")
+		if err != nil {
+			// rather than waiting for the timeout, fail fast if the dependency doesn't exist (yet)
+			if utils.ResponseWasNotFound(res.Response) {
+				return nil, "", fmt.Errorf("Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q) was not found - Extensions listed in `provision_after_extensions` must be created first, for example by using `depends_on`", name, vmName, resourceGroup)
+			}
+
+			return nil, "", fmt.Errorf("Error retrieving Virtual Machine Extension %q (Virtual Machine %q / Resource Group %q): %+v", name, vmName, resourceGroup, err)
+		}
+
+		if props := res.VirtualMachineExtensionProperties; props != nil && props.ProvisioningState != nil {
+			return res, *props.ProvisioningState, nil
+		}
+
+		return res, "", nil
+	}
+}
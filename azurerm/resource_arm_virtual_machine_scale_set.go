@@ -24,6 +24,8 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmVirtualMachineScaleSetCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -104,6 +106,18 @@ func resourceArmVirtualMachineScaleSet() *schema.Resource {
 				ForceNew: true,
 			},
 
+			"priority": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(compute.Regular),
+				ValidateFunc: validation.StringInSlice([]string{
+					string(compute.Low),
+					string(compute.Regular),
+				}, true),
+				DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+			},
+
 			"os_profile": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -640,6 +654,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 	updatePolicy := d.Get("upgrade_policy_mode").(string)
 	overprovision := d.Get("overprovision").(bool)
 	singlePlacementGroup := d.Get("single_placement_group").(bool)
+	priority := d.Get("priority").(string)
 
 	scaleSetProps := compute.VirtualMachineScaleSetProperties{
 		UpgradePolicy: &compute.UpgradePolicy{
@@ -650,6 +665,7 @@ func resourceArmVirtualMachineScaleSetCreate(d *schema.ResourceData, meta interf
 			StorageProfile:   &storageProfile,
 			OsProfile:        osProfile,
 			ExtensionProfile: extensions,
+			Priority:         compute.VirtualMachinePriorityTypes(priority),
 		},
 		Overprovision:        &overprovision,
 		SinglePlacementGroup: &singlePlacementGroup,
@@ -747,6 +763,13 @@ func resourceArmVirtualMachineScaleSetRead(d *schema.ResourceData, meta interfac
 	d.Set("overprovision", properties.Overprovision)
 	d.Set("single_placement_group", properties.SinglePlacementGroup)
 
+	// Scale Sets created before Priority was introduced don't return it
+	priority := string(compute.Regular)
+	if v := properties.VirtualMachineProfile.Priority; v != "" {
+		priority = string(v)
+	}
+	d.Set("priority", priority)
+
 	osProfile, err := flattenAzureRMVirtualMachineScaleSetOsProfile(d, properties.VirtualMachineProfile.OsProfile)
 	if err != nil {
 		return fmt.Errorf("[DEBUG] Error flattening Virtual Machine Scale Set OS Profile. Error: %#v", err)
@@ -1208,6 +1231,44 @@ func resourceArmVirtualMachineScaleSetStorageProfileImageReferenceHash(v interfa
 	return hashcode.String(buf.String())
 }
 
+func resourceArmVirtualMachineScaleSetCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	priority, ok := diff.GetOk("priority")
+	if !ok {
+		return nil
+	}
+
+	singlePlacementGroup := diff.Get("single_placement_group").(bool)
+
+	skuName := ""
+	for _, raw := range diff.Get("sku").(*schema.Set).List() {
+		sku := raw.(map[string]interface{})
+		skuName = sku["name"].(string)
+	}
+
+	return validateArmVirtualMachineScaleSetPriority(priority.(string), skuName, singlePlacementGroup)
+}
+
+// Low Priority Scale Sets must be limited to a Single Placement Group, and Low Priority capacity isn't available for
+// the B-Series or for the Promo sizes. The Eviction Policy isn't validated since it's not exposed in the Compute API
+// version used here - Low Priority instances are always deallocated.
+func validateArmVirtualMachineScaleSetPriority(priority string, skuName string, singlePlacementGroup bool) error {
+	if !strings.EqualFold(priority, string(compute.Low)) {
+		return nil
+	}
+
+	if !singlePlacementGroup {
+		return fmt.Errorf("Low Priority Scale Sets must be limited to a single placement group - `single_placement_group` must be `true` when `priority` is `Low`")
+	}
+
+	// the SKU name is empty when it isn't known until apply
+	name := strings.ToLower(skuName)
+	if strings.HasPrefix(name, "standard_b") || strings.HasSuffix(name, "_promo") {
+		return fmt.Errorf("The SKU %q isn't supported for Low Priority Scale Sets - the B-Series and Promo sizes can only be used with a `priority` of `Regular`", skuName)
+	}
+
+	return nil
+}
+
 func resourceArmVirtualMachineScaleSetSkuHash(v interface{}) int {
 	var buf bytes.Buffer
 	m := v.(map[string]interface{})
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/services/compute/mgmt/2017-12-01/compute"
@@ -12,6 +13,68 @@ import (
 	"github.com/hashicorp/terraform/terraform"
 )
 
+func TestResourceAzureRMVirtualMachineScaleSetPriority_validation(t *testing.T) {
+	cases := []struct {
+		Priority             string
+		SkuName              string
+		SinglePlacementGroup bool
+		ExpectError          bool
+	}{
+		{
+			Priority:             "Regular",
+			SkuName:              "Standard_B1s",
+			SinglePlacementGroup: false,
+			ExpectError:          false,
+		},
+		{
+			Priority:             "Low",
+			SkuName:              "Standard_D1_v2",
+			SinglePlacementGroup: true,
+			ExpectError:          false,
+		},
+		{
+			Priority:             "Low",
+			SkuName:              "",
+			SinglePlacementGroup: true,
+			ExpectError:          false,
+		},
+		{
+			Priority:             "Low",
+			SkuName:              "Standard_D1_v2",
+			SinglePlacementGroup: false,
+			ExpectError:          true,
+		},
+		{
+			Priority:             "Low",
+			SkuName:              "Standard_B2ms",
+			SinglePlacementGroup: true,
+			ExpectError:          true,
+		},
+		{
+			Priority:             "low",
+			SkuName:              "standard_b1s",
+			SinglePlacementGroup: true,
+			ExpectError:          true,
+		},
+		{
+			Priority:             "Low",
+			SkuName:              "Standard_D2_v2_Promo",
+			SinglePlacementGroup: true,
+			ExpectError:          true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateArmVirtualMachineScaleSetPriority(tc.Priority, tc.SkuName, tc.SinglePlacementGroup)
+		if tc.ExpectError && err == nil {
+			t.Fatalf("Expected an error for Priority %q / SKU %q / Single Placement Group %t but didn't get one", tc.Priority, tc.SkuName, tc.SinglePlacementGroup)
+		}
+		if !tc.ExpectError && err != nil {
+			t.Fatalf("Expected no error for Priority %q / SKU %q / Single Placement Group %t but got: %+v", tc.Priority, tc.SkuName, tc.SinglePlacementGroup, err)
+		}
+	}
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMVirtualMachineScaleSet_basic(ri, testLocation())
@@ -153,6 +216,59 @@ func TestAccAzureRMVirtualMachineScaleSet_singlePlacementGroupFalse(t *testing.T
 	})
 }
 
+func TestAccAzureRMVirtualMachineScaleSet_lowPriority(t *testing.T) {
+	resourceName := "azurerm_virtual_machine_scale_set.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualMachineScaleSet_lowPriority(ri, testLocation())
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualMachineScaleSetExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "priority", "Low"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSet_lowPriorityUnsupportedSku(t *testing.T) {
+	ri := acctest.RandInt()
+	config := strings.Replace(testAccAzureRMVirtualMachineScaleSet_lowPriority(ri, testLocation()), "Standard_D1_v2", "Standard_B1s", 1)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("isn't supported for Low Priority Scale Sets"),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualMachineScaleSet_lowPriorityMultiplePlacementGroups(t *testing.T) {
+	ri := acctest.RandInt()
+	config := strings.Replace(testAccAzureRMVirtualMachineScaleSet_lowPriority(ri, testLocation()), `priority = "Low"`, `priority = "Low"
+  single_placement_group = false`, 1)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualMachineScaleSetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("`single_placement_group` must be `true` when `priority` is `Low`"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualMachineScaleSet_linuxUpdated(t *testing.T) {
 	resourceName := "azurerm_virtual_machine_scale_set.test"
 	ri := acctest.RandInt()
@@ -1972,6 +2088,72 @@ resource "azurerm_virtual_machine_scale_set" "test" {
 `, rInt, location, rInt, rInt, rInt, rInt, rInt)
 }
 
+func testAccAzureRMVirtualMachineScaleSet_lowPriority(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+    name = "acctestRG-%d"
+    location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+    name = "acctvn-%d"
+    address_space = ["10.0.0.0/16"]
+    location = "${azurerm_resource_group.test.location}"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+}
+
+resource "azurerm_subnet" "test" {
+    name = "acctsub-%d"
+    resource_group_name = "${azurerm_resource_group.test.name}"
+    virtual_network_name = "${azurerm_virtual_network.test.name}"
+    address_prefix = "10.0.2.0/24"
+}
+
+resource "azurerm_virtual_machine_scale_set" "test" {
+  name = "acctvmss-%d"
+  location = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  upgrade_policy_mode = "Manual"
+  priority = "Low"
+
+  sku {
+    name = "Standard_D1_v2"
+    tier = "Standard"
+    capacity = 2
+  }
+
+  os_profile {
+    computer_name_prefix = "testvm-%d"
+    admin_username = "myadmin"
+    admin_password = "Passwword1234"
+  }
+
+  network_profile {
+    name = "TestNetworkProfile-%d"
+    primary = true
+    ip_configuration {
+      name = "TestIPConfiguration"
+      subnet_id = "${azurerm_subnet.test.id}"
+    }
+  }
+
+  storage_profile_os_disk {
+    name 		  = ""
+    caching       = "ReadWrite"
+    create_option = "FromImage"
+    managed_disk_type = "Standard_LRS"
+  }
+
+  storage_profile_image_reference {
+    publisher = "Canonical"
+    offer     = "UbuntuServer"
+    sku       = "16.04-LTS"
+    version   = "latest"
+  }
+}
+`, rInt, location, rInt, rInt, rInt, rInt, rInt)
+}
+
 func testAccAzureRMVirtualMachineScaleSet_basicLinux_managedDisk_withZones(rInt int, location string) string {
 	return fmt.Sprintf(`
 resource "azurerm_resource_group" "test" {
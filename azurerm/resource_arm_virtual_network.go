@@ -119,6 +119,9 @@ func resourceArmVirtualNetworkCreate(d *schema.ResourceData, meta interface{}) e
 	azureRMLockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 	defer azureRMUnlockMultipleByName(&networkSecurityGroupNames, networkSecurityGroupResourceName)
 
+	azureRMLockByName(name, virtualNetworkResourceName)
+	defer azureRMUnlockByName(name, virtualNetworkResourceName)
+
 	future, err := client.CreateOrUpdate(ctx, resGroup, name, vnet)
 	if err != nil {
 		return fmt.Errorf("Error Creating/Updating Virtual Network %q (Resource Group %q): %+v", name, resGroup, err)
@@ -206,8 +209,11 @@ func resourceArmVirtualNetworkDelete(d *schema.ResourceData, meta interface{}) e
 		return fmt.Errorf("[ERROR] Error parsing Network Security Group ID's: %+v", err)
 	}
 
-	azureRMLockMultipleByName(&nsgNames, virtualNetworkResourceName)
-	defer azureRMUnlockMultipleByName(&nsgNames, virtualNetworkResourceName)
+	azureRMLockMultipleByName(&nsgNames, networkSecurityGroupResourceName)
+	defer azureRMUnlockMultipleByName(&nsgNames, networkSecurityGroupResourceName)
+
+	azureRMLockByName(name, virtualNetworkResourceName)
+	defer azureRMUnlockByName(name, virtualNetworkResourceName)
 
 	future, err := client.Delete(ctx, resGroup, name)
 	if err != nil {
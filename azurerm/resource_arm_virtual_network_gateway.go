@@ -23,6 +23,8 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 			State: schema.ImportStatePassthrough,
 		},
 
+		CustomizeDiff: resourceArmVirtualNetworkGatewayCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:     schema.TypeString,
@@ -140,7 +142,7 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 						},
 						"root_certificate": {
 							Type:     schema.TypeSet,
-							Required: true,
+							Optional: true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
 									"name": {
@@ -172,6 +174,29 @@ func resourceArmVirtualNetworkGateway() *schema.Resource {
 							},
 							Set: hashVirtualNetworkGatewayRevokedCert,
 						},
+						"radius_server_address": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"radius_server_secret": {
+							Type:      schema.TypeString,
+							Optional:  true,
+							Sensitive: true,
+						},
+						"vpn_client_protocols": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+								ValidateFunc: validation.StringInSlice([]string{
+									string(network.IkeV2),
+									string(network.SSTP),
+								}, true),
+								DiffSuppressFunc: ignoreCaseDiffSuppressFunc,
+							},
+							Set: hashVirtualNetworkGatewayVpnClientProtocol,
+						},
 					},
 				},
 			},
@@ -257,6 +282,26 @@ func resourceArmVirtualNetworkGatewayCreateUpdate(d *schema.ResourceData, meta i
 	return resourceArmVirtualNetworkGatewayRead(d, meta)
 }
 
+func resourceArmVirtualNetworkGatewayCustomizeDiff(diff *schema.ResourceDiff, v interface{}) error {
+	for _, raw := range diff.Get("vpn_client_configuration").([]interface{}) {
+		config, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		// VPN Clients authenticate either using a Certificate signed by a Root Certificate, or using a RADIUS Server.
+		// Values which aren't known until apply read as empty, so the secret is also checked to avoid rejecting
+		// a RADIUS Server whose address is interpolated from another resource
+		hasRootCertificates := config["root_certificate"].(*schema.Set).Len() > 0
+		hasRadiusServer := config["radius_server_address"].(string) != "" || config["radius_server_secret"].(string) != ""
+		if !hasRootCertificates && !hasRadiusServer {
+			return fmt.Errorf("A `vpn_client_configuration` block must specify either one or more `root_certificate` blocks or a `radius_server_address` and `radius_server_secret`")
+		}
+	}
+
+	return nil
+}
+
 func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*ArmClient).vnetGatewayClient
 	ctx := meta.(*ArmClient).StopContext
@@ -304,6 +349,15 @@ func resourceArmVirtualNetworkGatewayRead(d *schema.ResourceData, meta interface
 
 		if gw.VpnClientConfiguration != nil {
 			vpnConfigFlat := flattenArmVirtualNetworkGatewayVpnClientConfig(gw.VpnClientConfiguration)
+
+			// the RADIUS Server Secret isn't returned from the API, so we persist the value from the config
+			if v, ok := d.GetOk("vpn_client_configuration.0.radius_server_secret"); ok {
+				vpnConfig := vpnConfigFlat[0].(map[string]interface{})
+				if _, exists := vpnConfig["radius_server_secret"]; !exists {
+					vpnConfig["radius_server_secret"] = v.(string)
+				}
+			}
+
 			if err := d.Set("vpn_client_configuration", vpnConfigFlat); err != nil {
 				return fmt.Errorf("Error setting `vpn_client_configuration`: %+v", err)
 			}
@@ -493,13 +547,32 @@ func expandArmVirtualNetworkGatewayVpnClientConfig(d *schema.ResourceData) *netw
 		revokedCerts = append(revokedCerts, r)
 	}
 
-	return &network.VpnClientConfiguration{
+	vpnClientProtocols := make([]network.VpnClientProtocol, 0)
+	for _, protocol := range conf["vpn_client_protocols"].(*schema.Set).List() {
+		vpnClientProtocols = append(vpnClientProtocols, network.VpnClientProtocol(protocol.(string)))
+	}
+
+	config := network.VpnClientConfiguration{
 		VpnClientAddressPool: &network.AddressSpace{
 			AddressPrefixes: &addresses,
 		},
 		VpnClientRootCertificates:    &rootCerts,
 		VpnClientRevokedCertificates: &revokedCerts,
 	}
+
+	if len(vpnClientProtocols) > 0 {
+		config.VpnClientProtocols = &vpnClientProtocols
+	}
+
+	if v := conf["radius_server_address"].(string); v != "" {
+		config.RadiusServerAddress = utils.String(v)
+	}
+
+	if v := conf["radius_server_secret"].(string); v != "" {
+		config.RadiusServerSecret = utils.String(v)
+	}
+
+	return &config
 }
 
 func expandArmVirtualNetworkGatewaySku(d *schema.ResourceData) *network.VirtualNetworkGatewaySku {
@@ -576,6 +649,22 @@ func flattenArmVirtualNetworkGatewayVpnClientConfig(cfg *network.VpnClientConfig
 	}
 	flat["revoked_certificate"] = schema.NewSet(hashVirtualNetworkGatewayRevokedCert, revokedCerts)
 
+	vpnClientProtocols := make([]interface{}, 0)
+	if protocols := cfg.VpnClientProtocols; protocols != nil {
+		for _, protocol := range *protocols {
+			vpnClientProtocols = append(vpnClientProtocols, string(protocol))
+		}
+	}
+	flat["vpn_client_protocols"] = schema.NewSet(hashVirtualNetworkGatewayVpnClientProtocol, vpnClientProtocols)
+
+	if v := cfg.RadiusServerAddress; v != nil {
+		flat["radius_server_address"] = *v
+	}
+
+	if v := cfg.RadiusServerSecret; v != nil {
+		flat["radius_server_secret"] = *v
+	}
+
 	return []interface{}{flat}
 }
 
@@ -599,6 +688,11 @@ func hashVirtualNetworkGatewayRevokedCert(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
+// the protocols are case-insensitive, so are hashed in lower-case to avoid a diff when the API returns a different casing
+func hashVirtualNetworkGatewayVpnClientProtocol(v interface{}) int {
+	return hashcode.String(strings.ToLower(v.(string)))
+}
+
 func resourceGroupAndVirtualNetworkGatewayFromId(virtualNetworkGatewayId string) (string, string, error) {
 	id, err := parseAzureResourceID(virtualNetworkGatewayId)
 	if err != nil {
@@ -90,9 +90,11 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				Computed: true,
 			},
 
+			// a Shared Key can't be removed from a Connection, so this is Computed to avoid a diff once it's unset
 			"shared_key": {
 				Type:      schema.TypeString,
 				Optional:  true,
+				Computed:  true,
 				Sensitive: true,
 			},
 
@@ -199,6 +201,21 @@ func resourceArmVirtualNetworkGatewayConnection() *schema.Resource {
 				},
 			},
 
+			"connection_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"ingress_bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
+			"egress_bytes_transferred": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+
 			"tags": tagsSchema(),
 		},
 	}
@@ -237,6 +254,24 @@ func resourceArmVirtualNetworkGatewayConnectionCreateUpdate(d *schema.ResourceDa
 		return fmt.Errorf("Error waiting for completion of Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
 	}
 
+	// changes to the Shared Key of an existing Connection are ignored by CreateOrUpdate
+	// so need to be applied separately to allow the key to be rotated in-place
+	if sharedKey := d.Get("shared_key").(string); !d.IsNewResource() && d.HasChange("shared_key") && sharedKey != "" {
+		parameters := network.ConnectionSharedKey{
+			Value: utils.String(sharedKey),
+		}
+
+		keyFuture, err := client.SetSharedKey(ctx, resGroup, name, parameters)
+		if err != nil {
+			return fmt.Errorf("Error updating Shared Key for Virtual Network Gateway Connection %q (Resource Group %q): %+v", name, resGroup, err)
+		}
+
+		err = keyFuture.WaitForCompletion(ctx, client.Client)
+		if err != nil {
+			return fmt.Errorf("Error waiting for the Shared Key of Virtual Network Gateway Connection %q (Resource Group %q) to be updated: %+v", name, resGroup, err)
+		}
+	}
+
 	read, err := client.Get(ctx, resGroup, name)
 	if err != nil {
 		return err
@@ -324,6 +359,16 @@ func resourceArmVirtualNetworkGatewayConnectionRead(d *schema.ResourceData, meta
 		}
 	}
 
+	d.Set("connection_status", string(conn.ConnectionStatus))
+
+	if conn.IngressBytesTransferred != nil {
+		d.Set("ingress_bytes_transferred", int(*conn.IngressBytesTransferred))
+	}
+
+	if conn.EgressBytesTransferred != nil {
+		d.Set("egress_bytes_transferred", int(*conn.EgressBytesTransferred))
+	}
+
 	flattenAndSetTags(d, resp.Tags)
 
 	return nil
@@ -23,6 +23,7 @@ func TestAccAzureRMVirtualNetworkGatewayConnection_sitetosite(t *testing.T) {
 				Config: config,
 				Check: resource.ComposeTestCheckFunc(
 					testCheckAzureRMVirtualNetworkGatewayConnectionExists("azurerm_virtual_network_gateway_connection.test"),
+					resource.TestCheckResourceAttrSet("azurerm_virtual_network_gateway_connection.test", "connection_status"),
 				),
 			},
 		},
@@ -87,6 +88,9 @@ func TestAccAzureRMVirtualNetworkGatewayConnection_updatingSharedKey(t *testing.
 	firstSharedKey := "4-v3ry-53cr37-1p53c-5h4r3d-k3y"
 	secondSharedKey := "4-r33ly-53cr37-1p53c-5h4r3d-k3y"
 
+	// the Connections should be updated in-place rather than being recreated
+	var firstId, secondId string
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:     func() { testAccPreCheck(t) },
 		Providers:    testAccProviders,
@@ -99,6 +103,8 @@ func TestAccAzureRMVirtualNetworkGatewayConnection_updatingSharedKey(t *testing.
 					testCheckAzureRMVirtualNetworkGatewayConnectionExists(secondResourceName),
 					resource.TestCheckResourceAttr(firstResourceName, "shared_key", firstSharedKey),
 					resource.TestCheckResourceAttr(secondResourceName, "shared_key", firstSharedKey),
+					testCheckAzureRMVirtualNetworkGatewayConnectionIdUnchanged(firstResourceName, &firstId),
+					testCheckAzureRMVirtualNetworkGatewayConnectionIdUnchanged(secondResourceName, &secondId),
 				),
 			},
 			{
@@ -108,6 +114,8 @@ func TestAccAzureRMVirtualNetworkGatewayConnection_updatingSharedKey(t *testing.
 					testCheckAzureRMVirtualNetworkGatewayConnectionExists(secondResourceName),
 					resource.TestCheckResourceAttr(firstResourceName, "shared_key", secondSharedKey),
 					resource.TestCheckResourceAttr(secondResourceName, "shared_key", secondSharedKey),
+					testCheckAzureRMVirtualNetworkGatewayConnectionIdUnchanged(firstResourceName, &firstId),
+					testCheckAzureRMVirtualNetworkGatewayConnectionIdUnchanged(secondResourceName, &secondId),
 				),
 			},
 		},
@@ -137,6 +145,46 @@ func testCheckAzureRMVirtualNetworkGatewayConnectionExists(name string) resource
 	}
 }
 
+// testCheckAzureRMVirtualNetworkGatewayConnectionIdUnchanged stores the ID and Resource GUID of the Connection in `id` the
+// first time it's called, and on subsequent calls checks that these match. The ID alone isn't enough to detect that the
+// Connection was recreated, since it's derived from the name - whereas Azure generates a new Resource GUID.
+func testCheckAzureRMVirtualNetworkGatewayConnectionIdUnchanged(name string, id *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		connectionName := rs.Primary.Attributes["name"]
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+
+		client := testAccProvider.Meta().(*ArmClient).vnetGatewayConnectionsClient
+		ctx := testAccProvider.Meta().(*ArmClient).StopContext
+
+		resp, err := client.Get(ctx, resourceGroup, connectionName)
+		if err != nil {
+			return fmt.Errorf("Bad: Get on vnetGatewayConnectionsClient: %+v", err)
+		}
+
+		resourceGuid := ""
+		if props := resp.VirtualNetworkGatewayConnectionPropertiesFormat; props != nil && props.ResourceGUID != nil {
+			resourceGuid = *props.ResourceGUID
+		}
+
+		actual := fmt.Sprintf("%s (Resource GUID %q)", rs.Primary.ID, resourceGuid)
+		if *id == "" {
+			*id = actual
+			return nil
+		}
+
+		if actual != *id {
+			return fmt.Errorf("Bad: expected %q to be updated in-place as %s but got %s", name, *id, actual)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMVirtualNetworkGatewayConnectionDestroy(s *terraform.State) error {
 	client := testAccProvider.Meta().(*ArmClient).vnetGatewayConnectionsClient
 	ctx := testAccProvider.Meta().(*ArmClient).StopContext
@@ -2,6 +2,8 @@ package azurerm
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform/helper/acctest"
@@ -10,6 +12,18 @@ import (
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
 
+func TestHashVirtualNetworkGatewayVpnClientProtocol(t *testing.T) {
+	for _, v := range []string{"ikev2", "IKEV2", "ikeV2"} {
+		if hashVirtualNetworkGatewayVpnClientProtocol(v) != hashVirtualNetworkGatewayVpnClientProtocol("IkeV2") {
+			t.Fatalf("Expected %q to have the same hash as %q", v, "IkeV2")
+		}
+	}
+
+	if hashVirtualNetworkGatewayVpnClientProtocol("SSTP") == hashVirtualNetworkGatewayVpnClientProtocol("IkeV2") {
+		t.Fatalf("Expected %q and %q to have different hashes", "SSTP", "IkeV2")
+	}
+}
+
 func TestAccAzureRMVirtualNetworkGateway_basic(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMVirtualNetworkGateway_basic(ri, testLocation())
@@ -67,6 +81,48 @@ func TestAccAzureRMVirtualNetworkGateway_vpnGw1(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMVirtualNetworkGateway_vpnClientConfigRadius(t *testing.T) {
+	resourceName := "azurerm_virtual_network_gateway.test"
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualNetworkGateway_vpnClientConfigRadius(ri, testLocation())
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMVirtualNetworkGatewayExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.radius_server_address", "1.2.3.4"),
+					resource.TestCheckResourceAttr(resourceName, "vpn_client_configuration.0.vpn_client_protocols.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMVirtualNetworkGateway_vpnClientConfigWithoutAuthentication(t *testing.T) {
+	ri := acctest.RandInt()
+	config := testAccAzureRMVirtualNetworkGateway_vpnClientConfigRadius(ri, testLocation())
+	config = strings.Replace(config, `    radius_server_address = "1.2.3.4"
+    radius_server_secret  = "1234"
+`, "", 1)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testCheckAzureRMVirtualNetworkGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("must specify either one or more `root_certificate` blocks or a `radius_server_address`"),
+			},
+		},
+	})
+}
+
 func TestAccAzureRMVirtualNetworkGateway_activeActive(t *testing.T) {
 	ri := acctest.RandInt()
 	config := testAccAzureRMVirtualNetworkGateway_activeActive(ri, testLocation())
@@ -339,3 +395,56 @@ resource "azurerm_virtual_network_gateway" "test" {
 }
 `, rInt, location, rInt, rInt, rInt, rInt)
 }
+
+func testAccAzureRMVirtualNetworkGateway_vpnClientConfigRadius(rInt int, location string) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+  address_space       = ["10.0.0.0/16"]
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "GatewaySubnet"
+  resource_group_name  = "${azurerm_resource_group.test.name}"
+  virtual_network_name = "${azurerm_virtual_network.test.name}"
+  address_prefix       = "10.0.1.0/24"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                         = "acctestpip-%d"
+  location                     = "${azurerm_resource_group.test.location}"
+  resource_group_name          = "${azurerm_resource_group.test.name}"
+  public_ip_address_allocation = "Dynamic"
+}
+
+resource "azurerm_virtual_network_gateway" "test" {
+  name                = "acctestvng-%d"
+  location            = "${azurerm_resource_group.test.location}"
+  resource_group_name = "${azurerm_resource_group.test.name}"
+
+  type     = "Vpn"
+  vpn_type = "RouteBased"
+  sku      = "VpnGw1"
+
+  ip_configuration {
+    public_ip_address_id          = "${azurerm_public_ip.test.id}"
+    private_ip_address_allocation = "Dynamic"
+    subnet_id                     = "${azurerm_subnet.test.id}"
+  }
+
+  vpn_client_configuration {
+    address_space         = ["10.2.0.0/24"]
+    vpn_client_protocols  = ["SSTP", "IkeV2"]
+    radius_server_address = "1.2.3.4"
+    radius_server_secret  = "1234"
+  }
+}
+`, rInt, location, rInt, rInt, rInt)
+}
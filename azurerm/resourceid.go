@@ -152,3 +152,12 @@ func parseRouteTableName(routeTableId string) (string, error) {
 
 	return id.Path["routeTables"], nil
 }
+
+func parseRouteFilterName(routeFilterId string) (string, error) {
+	id, err := parseAzureResourceID(routeFilterId)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Unable to parse Route Filter ID '%s': %+v", routeFilterId, err)
+	}
+
+	return id.Path["routeFilters"], nil
+}